@@ -0,0 +1,166 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package authoring
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/modelutils"
+)
+
+// ErrSourceMismatch defines an error returned when a control Markdown document was not
+// generated for the control implementation being assembled.
+var ErrSourceMismatch = errors.New("markdown source does not match control implementation source")
+
+// AssembleMarkdown updates a Component Definition control implementation with the content of control Markdown
+// documents.
+//
+// The implemented requirement description, statement descriptions and Rule_Id properties are replaced with the
+// document prose and rules. Parameter values from the header that differ from the values in effect for the control
+// are set on the implemented requirement set-parameters, so a document only changes the parameters of its own
+// control. The control implementation set-parameters are not modified. Requirements and statements not in the
+// OSCAL are added and all other fields are left unchanged.
+func AssembleMarkdown(controlImp *oscalTypes.ControlImplementationSet, docs ...ControlMarkdown) error {
+	for _, doc := range docs {
+		if doc.Header.Global.Source != "" && doc.Header.Global.Source != controlImp.Source {
+			return fmt.Errorf("control %q: %w: %q", doc.ControlID, ErrSourceMismatch, doc.Header.Global.Source)
+		}
+
+		requirement := findRequirement(controlImp, doc.ControlID, models.RandomUUID)
+		requirement.Description = doc.Prose
+		requirement.Props = replaceRuleProps(requirement.Props, doc.Rules)
+		for _, statementDoc := range doc.Statements {
+			statement := findStatement(requirement, statementDoc.StatementID, models.RandomUUID)
+			statement.Description = statementDoc.Prose
+			statement.Props = replaceRuleProps(statement.Props, statementDoc.Rules)
+		}
+
+		for _, paramValues := range doc.Header.ParameterValues {
+			for _, paramValue := range paramValues {
+				if slices.Equal(effectiveValues(controlImp, requirement, paramValue.Name), paramValue.Values) {
+					continue
+				}
+				if requirement.SetParameters == nil {
+					requirement.SetParameters = &[]oscalTypes.SetParameter{}
+				}
+				if !updateSetParameter(requirement.SetParameters, paramValue) {
+					*requirement.SetParameters = append(*requirement.SetParameters, oscalTypes.SetParameter{
+						ParamId: paramValue.Name,
+						Values:  paramValue.Values,
+					})
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// findRequirement returns the implemented requirement for a control, adding a new
+// implemented requirement if one does not exist.
+func findRequirement(controlImp *oscalTypes.ControlImplementationSet, controlId string, uuidFunc models.UUIDFunc) *oscalTypes.ImplementedRequirementControlImplementation {
+	for i := range controlImp.ImplementedRequirements {
+		if controlImp.ImplementedRequirements[i].ControlId == controlId {
+			return &controlImp.ImplementedRequirements[i]
+		}
+	}
+	controlImp.ImplementedRequirements = append(controlImp.ImplementedRequirements, oscalTypes.ImplementedRequirementControlImplementation{
+		UUID:      uuidFunc(fmt.Sprintf("implemented-requirement/%s/%s", controlImp.UUID, controlId)),
+		ControlId: controlId,
+	})
+	return &controlImp.ImplementedRequirements[len(controlImp.ImplementedRequirements)-1]
+}
+
+// findStatement returns the statement implementation for a statement id, adding a new
+// statement implementation if one does not exist.
+func findStatement(requirement *oscalTypes.ImplementedRequirementControlImplementation, statementId string, uuidFunc models.UUIDFunc) *oscalTypes.ControlStatementImplementation {
+	if requirement.Statements == nil {
+		requirement.Statements = &[]oscalTypes.ControlStatementImplementation{}
+	}
+	statements := *requirement.Statements
+	for i := range statements {
+		if statements[i].StatementId == statementId {
+			return &statements[i]
+		}
+	}
+	statements = append(statements, oscalTypes.ControlStatementImplementation{
+		UUID:        uuidFunc(fmt.Sprintf("statement/%s/%s", requirement.UUID, statementId)),
+		StatementId: statementId,
+	})
+	*requirement.Statements = statements
+	return &statements[len(statements)-1]
+}
+
+// replaceRuleProps returns the properties with the Rule_Id properties replaced by the given rules. Existing
+// Rule_Id properties for rules that are kept are not modified.
+func replaceRuleProps(props *[]oscalTypes.Property, ruleIds []string) *[]oscalTypes.Property {
+	wanted := set.New[string]()
+	for _, ruleId := range ruleIds {
+		wanted.Add(ruleId)
+	}
+	existing := set.New[string]()
+	var updated []oscalTypes.Property
+	if props != nil {
+		for _, prop := range *props {
+			if prop.Name == extensions.RuleIdProp {
+				if !wanted.Has(prop.Value) || existing.Has(prop.Value) {
+					continue
+				}
+				existing.Add(prop.Value)
+			}
+			updated = append(updated, prop)
+		}
+	}
+	for _, ruleId := range ruleIds {
+		if existing.Has(ruleId) {
+			continue
+		}
+		existing.Add(ruleId)
+		updated = append(updated, oscalTypes.Property{
+			Name:  extensions.RuleIdProp,
+			Value: ruleId,
+			Ns:    extensions.TrestleNameSpace,
+		})
+	}
+	return modelutils.NilIfEmpty(&updated)
+}
+
+// effectiveValues returns the values of a parameter for an implemented requirement. Values set on the
+// requirement take precedence over values set on the control implementation.
+func effectiveValues(controlImp *oscalTypes.ControlImplementationSet, requirement *oscalTypes.ImplementedRequirementControlImplementation, paramId string) []string {
+	for _, setParams := range []*[]oscalTypes.SetParameter{requirement.SetParameters, controlImp.SetParameters} {
+		if setParams == nil {
+			continue
+		}
+		for _, setParam := range *setParams {
+			if setParam.ParamId == paramId {
+				return setParam.Values
+			}
+		}
+	}
+	return nil
+}
+
+// updateSetParameter sets the values of an existing set-parameter and returns whether
+// the parameter was found.
+func updateSetParameter(setParams *[]oscalTypes.SetParameter, paramValue ParameterValueInfo) bool {
+	if setParams == nil {
+		return false
+	}
+	for i := range *setParams {
+		if (*setParams)[i].ParamId == paramValue.Name {
+			(*setParams)[i].Values = paramValue.Values
+			return true
+		}
+	}
+	return false
+}
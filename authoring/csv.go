@@ -0,0 +1,511 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package authoring
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+	"github.com/oscal-compass/oscal-sdk-go/models/modelutils"
+)
+
+// Column names from the trestle csv-to-oscal-cd format.
+const (
+	ComponentTitleColumn             = "Component_Title"
+	ComponentDescriptionColumn       = "Component_Description"
+	ComponentTypeColumn              = "Component_Type"
+	RuleIdColumn                     = "Rule_Id"
+	RuleDescriptionColumn            = "Rule_Description"
+	ProfileSourceColumn              = "Profile_Source"
+	ProfileDescriptionColumn         = "Profile_Description"
+	ControlIdListColumn              = "Control_Id_List"
+	NamespaceColumn                  = "Namespace"
+	ParameterIdColumn                = "Parameter_Id"
+	ParameterDescriptionColumn       = "Parameter_Description"
+	ParameterValueAlternativesColumn = "Parameter_Value_Alternatives"
+	ParameterValueDefaultColumn      = "Parameter_Value_Default"
+	CheckIdColumn                    = "Check_Id"
+	CheckDescriptionColumn           = "Check_Description"
+
+	// parameterAlternativesProp is the property name for the alternative values of a parameter.
+	parameterAlternativesProp = "Parameter_Value_Alternatives"
+	ruleSetRemarksPrefix      = "rule_set_"
+	statementSeparator        = "_smt"
+)
+
+// ErrMissingColumn defines an error returned when a required column is not in the CSV header.
+var ErrMissingColumn = errors.New("missing required column")
+
+// columns are the columns written by WriteCSV in order with a description for the
+// second header row.
+var columns = []struct {
+	name        string
+	required    bool
+	description string
+}{
+	{ComponentTitleColumn, true, "A human readable name for the component."},
+	{ComponentDescriptionColumn, true, "A description of the component including information about its function."},
+	{ComponentTypeColumn, true, "A category describing the purpose of the component."},
+	{RuleIdColumn, true, "A textual label that uniquely identifies a policy (desired state) that can be used to reference it elsewhere in this or other documents."},
+	{RuleDescriptionColumn, true, "A description of the policy (desired state) including information about its purpose and scope."},
+	{ProfileSourceColumn, true, "A URL reference to the source catalog or profile for which this component is implementing controls for."},
+	{ProfileDescriptionColumn, true, "A description of the profile."},
+	{ControlIdListColumn, true, "A list of textual labels that uniquely identify the controls or statements that the component implements."},
+	{NamespaceColumn, true, "A namespace qualifying the property name."},
+	{ParameterIdColumn, false, "A textual label that uniquely identifies the parameter associated with that policy (desired state) or controls implemented by the policy (desired state)."},
+	{ParameterDescriptionColumn, false, "A description of the parameter."},
+	{ParameterValueAlternativesColumn, false, "A list of values that may be selected for the parameter."},
+	{ParameterValueDefaultColumn, false, "The default value of the parameter."},
+	{CheckIdColumn, false, "A textual label that uniquely identifies a check of the policy (desired state) evaluation that can be used to reference it elsewhere in this or other documents."},
+	{CheckDescriptionColumn, false, "A description of the check of the policy (desired state) evaluation."},
+}
+
+type csvOpts struct {
+	title string
+	uuid  models.UUIDFunc
+}
+
+func (c *csvOpts) defaults() {
+	c.title = models.SampleRequiredString
+	c.uuid = models.RandomUUID
+}
+
+// CSVOption defines an option to tune the behavior of the ReadCSV function.
+type CSVOption func(opts *csvOpts)
+
+// WithTitle is a CSVOption that sets the ComponentDefinition title in the metadata.
+func WithTitle(title string) CSVOption {
+	return func(opts *csvOpts) {
+		opts.title = title
+	}
+}
+
+// WithUUIDFunc is a CSVOption that sets the source of UUIDs for the ComponentDefinition.
+// Use models.ContentUUID to generate the same UUIDs for the same CSV.
+func WithUUIDFunc(uuidFunc models.UUIDFunc) CSVOption {
+	return func(opts *csvOpts) {
+		opts.uuid = uuidFunc
+	}
+}
+
+// ReadCSV reads a ComponentDefinition from a CSV in the trestle csv-to-oscal-cd format.
+//
+// The first row contains the column names, optionally prefixed with "$$" for required columns or "$" for
+// optional columns, and the second row contains column descriptions and is ignored. Each row maps a rule to a
+// component. Rows for validation components add the rule and check properties to the component. Rows for other
+// components add the rule and parameter properties to the component and map the rule to the controls in the
+// control implementation for the profile source. Control ids with a statement part map the rule to the statement.
+// Rule properties for each rule are grouped by the property remarks and parameter default values are added as
+// control implementation set-parameters.
+func ReadCSV(r io.Reader, opts ...CSVOption) (*oscalTypes.ComponentDefinition, error) {
+	options := csvOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMissingColumn, ComponentTitleColumn)
+	}
+	index := make(map[string]int)
+	for i, name := range records[0] {
+		index[strings.TrimLeft(strings.TrimSpace(name), "$")] = i
+	}
+	for _, column := range columns {
+		if _, ok := index[column.name]; column.required && !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingColumn, column.name)
+		}
+	}
+
+	builder := newDefinitionBuilder(options.uuid)
+	for rowIdx, record := range records {
+		if rowIdx < 2 {
+			continue
+		}
+		row := csvRow{record: record, index: index}
+		if row.get(ComponentTitleColumn) == "" || row.get(RuleIdColumn) == "" {
+			continue
+		}
+		builder.add(row)
+	}
+
+	metadata := models.NewSampleMetadata()
+	metadata.Title = options.title
+	definition := &oscalTypes.ComponentDefinition{
+		UUID:     options.uuid("component-definition"),
+		Metadata: metadata,
+	}
+	definedComponents := builder.definedComponents()
+	definition.Components = modelutils.NilIfEmpty(&definedComponents)
+	return definition, nil
+}
+
+// WriteCSV writes the components of a ComponentDefinition as a CSV in the trestle csv-to-oscal-cd format.
+// A row is written for each rule and parameter of a component and each control implementation source.
+func WriteCSV(w io.Writer, definition oscalTypes.ComponentDefinition) error {
+	writer := csv.NewWriter(w)
+	var names, descriptions []string
+	for _, column := range columns {
+		prefix := "$"
+		if column.required {
+			prefix = "$$"
+		}
+		names = append(names, prefix+column.name)
+		descriptions = append(descriptions, column.description)
+	}
+	if err := writer.Write(names); err != nil {
+		return err
+	}
+	if err := writer.Write(descriptions); err != nil {
+		return err
+	}
+
+	if definition.Components != nil {
+		for _, component := range *definition.Components {
+			for _, record := range componentRecords(component) {
+				if err := writer.Write(record); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvRow provides access to the values of a CSV record by column name.
+type csvRow struct {
+	record []string
+	index  map[string]int
+}
+
+func (c csvRow) get(column string) string {
+	i, ok := c.index[column]
+	if !ok || i >= len(c.record) {
+		return ""
+	}
+	return strings.TrimSpace(c.record[i])
+}
+
+// definitionBuilder builds the components of a ComponentDefinition from CSV rows.
+type definitionBuilder struct {
+	components []*componentBuilder
+	byTitle    map[string]*componentBuilder
+	uuid       models.UUIDFunc
+}
+
+// componentBuilder builds a single component with rule sets and control implementations.
+type componentBuilder struct {
+	component       oscalTypes.DefinedComponent
+	props           []oscalTypes.Property
+	ruleSets        map[string]string
+	paramCounts     map[string]int
+	implementations []*oscalTypes.ControlImplementationSet
+	bySource        map[string]*oscalTypes.ControlImplementationSet
+	uuid            models.UUIDFunc
+}
+
+func newDefinitionBuilder(uuidFunc models.UUIDFunc) *definitionBuilder {
+	return &definitionBuilder{byTitle: make(map[string]*componentBuilder), uuid: uuidFunc}
+}
+
+func (d *definitionBuilder) add(row csvRow) {
+	title := row.get(ComponentTitleColumn)
+	builder, ok := d.byTitle[title]
+	if !ok {
+		builder = &componentBuilder{
+			component: oscalTypes.DefinedComponent{
+				UUID:        d.uuid(fmt.Sprintf("component/%s", title)),
+				Title:       title,
+				Description: row.get(ComponentDescriptionColumn),
+				Type:        row.get(ComponentTypeColumn),
+			},
+			ruleSets:    make(map[string]string),
+			paramCounts: make(map[string]int),
+			bySource:    make(map[string]*oscalTypes.ControlImplementationSet),
+			uuid:        d.uuid,
+		}
+		d.byTitle[title] = builder
+		d.components = append(d.components, builder)
+	}
+	builder.add(row)
+}
+
+func (d *definitionBuilder) definedComponents() []oscalTypes.DefinedComponent {
+	var definedComponents []oscalTypes.DefinedComponent
+	for _, builder := range d.components {
+		component := builder.component
+		component.Props = modelutils.NilIfEmpty(&builder.props)
+		var controlImps []oscalTypes.ControlImplementationSet
+		for _, controlImp := range builder.implementations {
+			controlImps = append(controlImps, *controlImp)
+		}
+		component.ControlImplementations = modelutils.NilIfEmpty(&controlImps)
+		definedComponents = append(definedComponents, component)
+	}
+	return definedComponents
+}
+
+func (c *componentBuilder) add(row csvRow) {
+	ruleId := row.get(RuleIdColumn)
+	namespace := row.get(NamespaceColumn)
+	if namespace == "" {
+		namespace = extensions.TrestleNameSpace
+	}
+	remarks, existing := c.ruleSets[ruleId]
+	if !existing {
+		remarks = fmt.Sprintf("%s%02d", ruleSetRemarksPrefix, len(c.ruleSets))
+		c.ruleSets[ruleId] = remarks
+	}
+	addProp := func(name, value string) {
+		if value != "" {
+			c.props = append(c.props, oscalTypes.Property{Name: name, Value: value, Ns: namespace, Remarks: remarks})
+		}
+	}
+
+	if !existing {
+		addProp(extensions.RuleIdProp, ruleId)
+		addProp(extensions.RuleDescriptionProp, row.get(RuleDescriptionColumn))
+	}
+	if strings.EqualFold(c.component.Type, string(components.Validation)) {
+		addProp(extensions.CheckIdProp, row.get(CheckIdColumn))
+		addProp(extensions.CheckDescriptionProp, row.get(CheckDescriptionColumn))
+		return
+	}
+
+	if paramId := row.get(ParameterIdColumn); paramId != "" {
+		suffix := ""
+		if count := c.paramCounts[ruleId]; count > 0 {
+			suffix = fmt.Sprintf("_%d", count)
+		}
+		c.paramCounts[ruleId]++
+		addProp(extensions.ParameterIdProp+suffix, paramId)
+		addProp(extensions.ParameterDescriptionProp+suffix, row.get(ParameterDescriptionColumn))
+		addProp(parameterAlternativesProp+suffix, row.get(ParameterValueAlternativesColumn))
+		addProp(extensions.ParameterDefaultProp+suffix, row.get(ParameterValueDefaultColumn))
+	}
+
+	source := row.get(ProfileSourceColumn)
+	if source == "" {
+		return
+	}
+	controlImp, ok := c.bySource[source]
+	if !ok {
+		controlImp = &oscalTypes.ControlImplementationSet{
+			UUID:        c.uuid(fmt.Sprintf("control-implementation/%s/%s", c.component.UUID, source)),
+			Source:      source,
+			Description: row.get(ProfileDescriptionColumn),
+		}
+		c.bySource[source] = controlImp
+		c.implementations = append(c.implementations, controlImp)
+	}
+	if paramId, value := row.get(ParameterIdColumn), row.get(ParameterValueDefaultColumn); paramId != "" && value != "" {
+		setParameter(controlImp, paramId, value)
+	}
+	ruleProp := oscalTypes.Property{Name: extensions.RuleIdProp, Value: ruleId, Ns: namespace}
+	for _, id := range strings.Fields(row.get(ControlIdListColumn)) {
+		controlId, _, isStatement := strings.Cut(id, statementSeparator)
+		requirement := findRequirement(controlImp, controlId, c.uuid)
+		if isStatement {
+			statement := findStatement(requirement, id, c.uuid)
+			statement.Props = appendRuleProp(statement.Props, ruleProp)
+			continue
+		}
+		requirement.Props = appendRuleProp(requirement.Props, ruleProp)
+	}
+}
+
+// setParameter adds a set-parameter to the control implementation if the parameter is not set.
+func setParameter(controlImp *oscalTypes.ControlImplementationSet, paramId, value string) {
+	if controlImp.SetParameters == nil {
+		controlImp.SetParameters = &[]oscalTypes.SetParameter{}
+	}
+	for _, setParam := range *controlImp.SetParameters {
+		if setParam.ParamId == paramId {
+			return
+		}
+	}
+	*controlImp.SetParameters = append(*controlImp.SetParameters, oscalTypes.SetParameter{
+		ParamId: paramId,
+		Values:  []string{value},
+	})
+}
+
+// appendRuleProp adds a Rule_Id property if a property for the rule does not exist.
+func appendRuleProp(props *[]oscalTypes.Property, ruleProp oscalTypes.Property) *[]oscalTypes.Property {
+	if props == nil {
+		props = &[]oscalTypes.Property{}
+	}
+	for _, prop := range *props {
+		if prop.Name == ruleProp.Name && prop.Value == ruleProp.Value {
+			return props
+		}
+	}
+	*props = append(*props, ruleProp)
+	return props
+}
+
+// componentRecords returns the CSV records for a component.
+func componentRecords(component oscalTypes.DefinedComponent) [][]string {
+	if component.Props == nil {
+		return nil
+	}
+
+	var records [][]string
+	for _, group := range groupRuleProps(*component.Props) {
+		ruleId := group.get(extensions.RuleIdProp)
+		if ruleId == "" {
+			continue
+		}
+		base := map[string]string{
+			ComponentTitleColumn:       component.Title,
+			ComponentDescriptionColumn: component.Description,
+			ComponentTypeColumn:        component.Type,
+			RuleIdColumn:               ruleId,
+			RuleDescriptionColumn:      group.get(extensions.RuleDescriptionProp),
+			NamespaceColumn:            group.namespace,
+			CheckIdColumn:              group.get(extensions.CheckIdProp),
+			CheckDescriptionColumn:     group.get(extensions.CheckDescriptionProp),
+		}
+
+		var parameters []map[string]string
+		for _, suffix := range group.parameterSuffixes() {
+			parameters = append(parameters, map[string]string{
+				ParameterIdColumn:                group.get(extensions.ParameterIdProp + suffix),
+				ParameterDescriptionColumn:       group.get(extensions.ParameterDescriptionProp + suffix),
+				ParameterValueAlternativesColumn: group.get(parameterAlternativesProp + suffix),
+				ParameterValueDefaultColumn:      group.get(extensions.ParameterDefaultProp + suffix),
+			})
+		}
+		if len(parameters) == 0 {
+			parameters = append(parameters, map[string]string{})
+		}
+
+		sources := controlsByRule(component, ruleId)
+		if len(sources) == 0 {
+			sources = append(sources, ruleControls{})
+		}
+		for _, source := range sources {
+			for _, parameter := range parameters {
+				values := map[string]string{
+					ProfileSourceColumn:      source.source,
+					ProfileDescriptionColumn: source.description,
+					ControlIdListColumn:      strings.Join(source.controlIds, " "),
+				}
+				var record []string
+				for _, column := range columns {
+					value, ok := values[column.name]
+					if !ok {
+						value, ok = parameter[column.name]
+					}
+					if !ok {
+						value = base[column.name]
+					}
+					record = append(record, value)
+				}
+				records = append(records, record)
+			}
+		}
+	}
+	return records
+}
+
+// ruleControls defines the controls mapped to a rule in a control implementation.
+type ruleControls struct {
+	source      string
+	description string
+	controlIds  []string
+}
+
+// controlsByRule returns the controls and statements mapped to a rule for each control implementation.
+func controlsByRule(component oscalTypes.DefinedComponent, ruleId string) []ruleControls {
+	if component.ControlImplementations == nil {
+		return nil
+	}
+	var sources []ruleControls
+	for _, controlImp := range *component.ControlImplementations {
+		controls := ruleControls{source: controlImp.Source, description: controlImp.Description}
+		for _, requirement := range components.NewControlImplementationSetAdapter(controlImp).Requirements() {
+			if hasRule(requirement.Props(), ruleId) {
+				controls.controlIds = append(controls.controlIds, requirement.ControlID())
+			}
+			for _, statement := range requirement.Statements() {
+				if hasRule(statement.Props(), ruleId) {
+					controls.controlIds = append(controls.controlIds, statement.StatementID())
+				}
+			}
+		}
+		if len(controls.controlIds) > 0 {
+			sources = append(sources, controls)
+		}
+	}
+	return sources
+}
+
+func hasRule(props []oscalTypes.Property, ruleId string) bool {
+	for _, id := range ruleIds(props) {
+		if id == ruleId {
+			return true
+		}
+	}
+	return false
+}
+
+// ruleGroup defines the properties for a rule set grouped by remarks.
+type ruleGroup struct {
+	namespace string
+	names     []string
+	values    map[string]string
+}
+
+func (r ruleGroup) get(name string) string {
+	return r.values[name]
+}
+
+// parameterSuffixes returns the suffixes of the parameter properties in the group in order.
+func (r ruleGroup) parameterSuffixes() []string {
+	var suffixes []string
+	for _, name := range r.names {
+		if suffix, found := strings.CutPrefix(name, extensions.ParameterIdProp); found {
+			suffixes = append(suffixes, suffix)
+		}
+	}
+	return suffixes
+}
+
+// groupRuleProps returns the properties grouped by remarks in order of appearance.
+func groupRuleProps(props []oscalTypes.Property) []ruleGroup {
+	var groups []ruleGroup
+	byRemarks := make(map[string]int)
+	for _, prop := range props {
+		if prop.Remarks == "" {
+			continue
+		}
+		i, ok := byRemarks[prop.Remarks]
+		if !ok {
+			i = len(groups)
+			byRemarks[prop.Remarks] = i
+			groups = append(groups, ruleGroup{namespace: prop.Ns, values: make(map[string]string)})
+		}
+		groups[i].names = append(groups[i].names, prop.Name)
+		groups[i].values[prop.Name] = prop.Value
+	}
+	return groups
+}
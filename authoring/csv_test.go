@@ -0,0 +1,120 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package authoring
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+	"github.com/oscal-compass/oscal-sdk-go/rules"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+func TestReadCSV(t *testing.T) {
+	file, err := os.Open("../testdata/component-definition.csv")
+	require.NoError(t, err)
+	defer file.Close()
+
+	definition, err := ReadCSV(file, WithTitle("CSV Component Definition"))
+	require.NoError(t, err)
+	require.Equal(t, "CSV Component Definition", definition.Metadata.Title)
+	require.NoError(t, validation.NewSchemaValidator().Validate(oscalTypes.OscalModels{ComponentDefinition: definition}))
+	require.NotNil(t, definition.Components)
+	require.Len(t, *definition.Components, 2)
+
+	var adapters []components.Component
+	for _, component := range *definition.Components {
+		adapters = append(adapters, components.NewDefinedComponentAdapter(component))
+	}
+	store := rules.NewMemoryStore()
+	require.NoError(t, store.IndexAll(adapters))
+	ruleSet, err := store.GetByRuleID(context.TODO(), "etcd_key_file")
+	require.NoError(t, err)
+	require.Equal(t, "Ensure that the --key-file argument is set as appropriate", ruleSet.Rule.Description)
+	require.ElementsMatch(t, []extensions.Parameter{
+		{ID: "file_name", Description: "A parameter for a file name", Value: "file_name_override"},
+		{ID: "file_mode", Description: "A parameter for a file mode", Value: "0600"},
+	}, ruleSet.Rule.Parameters)
+	require.Equal(t, []extensions.Check{
+		{ID: "etcd_key_file_check", Description: "Check that the --key-file argument is set as appropriate"},
+	}, ruleSet.Checks)
+
+	target := (*definition.Components)[0]
+	require.Equal(t, "TestKubernetes", target.Title)
+	require.NotNil(t, target.ControlImplementations)
+	controlImps := *target.ControlImplementations
+	require.Len(t, controlImps, 2)
+	require.Equal(t, "profiles/cis/profile.json", controlImps[0].Source)
+	require.Equal(t, "CIS Profile", controlImps[0].Description)
+	require.Equal(t, []oscalTypes.SetParameter{
+		{ParamId: "file_name", Values: []string{"file_name_override"}},
+		{ParamId: "file_mode", Values: []string{"0600"}},
+	}, *controlImps[0].SetParameters)
+
+	requirements := controlImps[0].ImplementedRequirements
+	require.Len(t, requirements, 2)
+	require.Equal(t, "CIS-2.1", requirements[0].ControlId)
+	require.Equal(t, []string{"etcd_key_file", "etcd_cert_file"}, ruleIds(*requirements[0].Props))
+	require.Equal(t, "CIS-2.2", requirements[1].ControlId)
+	require.Nil(t, requirements[1].Props)
+	require.Equal(t, "CIS-2.2_smt.a", (*requirements[1].Statements)[0].StatementId)
+	require.Equal(t, []string{"etcd_key_file"}, ruleIds(*(*requirements[1].Statements)[0].Props))
+
+	require.Equal(t, "profiles/nist/profile.json", controlImps[1].Source)
+	require.Equal(t, "sc-8", controlImps[1].ImplementedRequirements[0].ControlId)
+}
+
+func TestReadCSV_Reproducible(t *testing.T) {
+	read := func() *oscalTypes.ComponentDefinition {
+		file, err := os.Open("../testdata/component-definition.csv")
+		require.NoError(t, err)
+		defer file.Close()
+		definition, err := ReadCSV(file, WithUUIDFunc(models.ContentUUID("test")))
+		require.NoError(t, err)
+		return definition
+	}
+
+	first, second := read(), read()
+	require.Equal(t, first.UUID, second.UUID)
+	require.Equal(t, first.Components, second.Components)
+	require.NoError(t, validation.NewSchemaValidator().Validate(oscalTypes.OscalModels{ComponentDefinition: first}))
+}
+
+func TestReadCSV_MissingColumn(t *testing.T) {
+	_, err := ReadCSV(strings.NewReader("$$Component_Title,$$Rule_Id\n"))
+	require.ErrorIs(t, err, ErrMissingColumn)
+}
+
+func TestWriteCSV(t *testing.T) {
+	input, err := os.ReadFile("../testdata/component-definition.csv")
+	require.NoError(t, err)
+	definition, err := ReadCSV(bytes.NewReader(input))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, *definition))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	wantLines := strings.Split(strings.TrimSpace(string(input)), "\n")
+	require.Equal(t, wantLines[0], lines[0])
+	require.Equal(t, wantLines[2:], lines[2:])
+
+	roundTrip, err := ReadCSV(&buf)
+	require.NoError(t, err)
+	var roundTripBuf bytes.Buffer
+	require.NoError(t, WriteCSV(&roundTripBuf, *roundTrip))
+	var again bytes.Buffer
+	require.NoError(t, WriteCSV(&again, *definition))
+	require.Equal(t, again.String(), roundTripBuf.String())
+}
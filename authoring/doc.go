@@ -0,0 +1,10 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+/*
+Package authoring defines logic for authoring OSCAL Component Definitions as trestle-style Markdown documents
+with YAML headers or CSV spreadsheets and assembling the edited documents back into OSCAL.
+*/
+package authoring
@@ -0,0 +1,340 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package authoring
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"gopkg.in/yaml.v3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+	"github.com/oscal-compass/oscal-sdk-go/rules"
+)
+
+const (
+	headerDelimiter       = "---"
+	implementationHeading = "## Implementation for "
+	statementPrefix       = "part "
+	rulesHeading          = "### Rules"
+)
+
+// ErrMissingControl defines an error returned when a Markdown document does not
+// define a control heading.
+var ErrMissingControl = errors.New("markdown document is missing a control heading")
+
+// Header defines the YAML header of a control Markdown document. Rules and parameters
+// are keyed by component title. Header fields not defined here are kept in Extra.
+type Header struct {
+	Rules           map[string][]RuleInfo           `yaml:"x-trestle-comp-def-rules,omitempty"`
+	Parameters      map[string][]ParameterInfo      `yaml:"x-trestle-rules-params,omitempty"`
+	ParameterValues map[string][]ParameterValueInfo `yaml:"x-trestle-comp-def-rules-param-vals,omitempty"`
+	Global          GlobalInfo                      `yaml:"x-trestle-global,omitempty"`
+	Extra           map[string]any                  `yaml:",inline"`
+}
+
+// RuleInfo defines a rule in the Markdown header.
+type RuleInfo struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// ParameterInfo defines a rule parameter in the Markdown header.
+type ParameterInfo struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+	RuleID      string `yaml:"rule-id,omitempty"`
+}
+
+// ParameterValueInfo defines the values set for a rule parameter in the Markdown header.
+type ParameterValueInfo struct {
+	Name   string   `yaml:"name"`
+	Values []string `yaml:"values"`
+}
+
+// GlobalInfo defines the information for the control Markdown document.
+type GlobalInfo struct {
+	// SortID is the control id used for sorting documents.
+	SortID string `yaml:"sort-id,omitempty"`
+	// Source is the source of the control implementation.
+	Source string `yaml:"source,omitempty"`
+}
+
+// ControlMarkdown defines a trestle-style Markdown document for the implementation
+// of a single control by a component.
+type ControlMarkdown struct {
+	Header    Header
+	ControlID string
+	// Prose is the implementation description for the control.
+	Prose string
+	// Rules are the rules mapped to the control.
+	Rules []string
+	// Statements are the implementations of the control statements.
+	Statements []StatementMarkdown
+}
+
+// StatementMarkdown defines the implementation of a control statement in a control Markdown document.
+type StatementMarkdown struct {
+	StatementID string
+	// Prose is the implementation description for the statement.
+	Prose string
+	// Rules are the rules mapped to the statement.
+	Rules []string
+}
+
+// GenerateMarkdown returns a ControlMarkdown for each implemented requirement in the control implementations
+// of a Component Definition component.
+//
+// Rule and parameter information is read from the component properties. Parameter values are set from the
+// implemented requirement set-parameters, falling back to the control implementation set-parameters.
+func GenerateMarkdown(component oscalTypes.DefinedComponent) ([]ControlMarkdown, error) {
+	componentAdapter := components.NewDefinedComponentAdapter(component)
+	store := rules.NewMemoryStore()
+	if err := store.IndexAll([]components.Component{componentAdapter}); err != nil {
+		return nil, err
+	}
+	if component.ControlImplementations == nil {
+		return nil, nil
+	}
+
+	var docs []ControlMarkdown
+	for _, controlImp := range *component.ControlImplementations {
+		implementation := components.NewControlImplementationSetAdapter(controlImp)
+		for i, requirement := range implementation.Requirements() {
+			rawRequirement := controlImp.ImplementedRequirements[i]
+			doc := newControlMarkdown(store, componentAdapter.Title(), implementation, requirement)
+			doc.Header.Global.Source = controlImp.Source
+			doc.Prose = rawRequirement.Description
+			if rawRequirement.Statements != nil {
+				for j := range doc.Statements {
+					doc.Statements[j].Prose = (*rawRequirement.Statements)[j].Description
+				}
+			}
+			docs = append(docs, doc)
+		}
+	}
+	return docs, nil
+}
+
+// newControlMarkdown returns a ControlMarkdown for a Requirement with rule information from the Store.
+func newControlMarkdown(store rules.Store, componentTitle string, implementation components.Implementation, requirement components.Requirement) ControlMarkdown {
+	doc := ControlMarkdown{
+		ControlID: requirement.ControlID(),
+		Rules:     ruleIds(requirement.Props()),
+		Header: Header{
+			Global: GlobalInfo{SortID: requirement.ControlID()},
+		},
+	}
+	allRules := append([]string{}, doc.Rules...)
+	for _, statement := range requirement.Statements() {
+		statementRules := ruleIds(statement.Props())
+		doc.Statements = append(doc.Statements, StatementMarkdown{
+			StatementID: statement.StatementID(),
+			Rules:       statementRules,
+		})
+		allRules = append(allRules, statementRules...)
+	}
+
+	values := make(map[string][]string)
+	for _, setParam := range implementation.SetParameters() {
+		values[setParam.ParamId] = setParam.Values
+	}
+	for _, setParam := range requirement.SetParameters() {
+		values[setParam.ParamId] = setParam.Values
+	}
+
+	var ruleInfos []RuleInfo
+	var paramInfos []ParameterInfo
+	var valueInfos []ParameterValueInfo
+	seen := make(map[string]bool)
+	for _, ruleId := range allRules {
+		if seen[ruleId] {
+			continue
+		}
+		seen[ruleId] = true
+		ruleSet, err := store.GetByRuleID(context.Background(), ruleId)
+		if err != nil {
+			ruleInfos = append(ruleInfos, RuleInfo{Name: ruleId})
+			continue
+		}
+		ruleInfos = append(ruleInfos, RuleInfo{Name: ruleId, Description: ruleSet.Rule.Description})
+		parameters := append([]extensions.Parameter{}, ruleSet.Rule.Parameters...)
+		sort.Slice(parameters, func(i, j int) bool {
+			return parameters[i].ID < parameters[j].ID
+		})
+		for _, param := range parameters {
+			paramInfos = append(paramInfos, ParameterInfo{Name: param.ID, Description: param.Description, RuleID: ruleId})
+			if paramValues, ok := values[param.ID]; ok {
+				valueInfos = append(valueInfos, ParameterValueInfo{Name: param.ID, Values: paramValues})
+			}
+		}
+	}
+	if len(ruleInfos) > 0 {
+		doc.Header.Rules = map[string][]RuleInfo{componentTitle: ruleInfos}
+	}
+	if len(paramInfos) > 0 {
+		doc.Header.Parameters = map[string][]ParameterInfo{componentTitle: paramInfos}
+	}
+	if len(valueInfos) > 0 {
+		doc.Header.ParameterValues = map[string][]ParameterValueInfo{componentTitle: valueInfos}
+	}
+	return doc
+}
+
+// WriteMarkdown writes a ControlMarkdown as a Markdown document with a YAML header.
+func WriteMarkdown(w io.Writer, doc ControlMarkdown) error {
+	var header bytes.Buffer
+	encoder := yaml.NewEncoder(&header)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc.Header); err != nil {
+		return fmt.Errorf("failed to marshal markdown header: %w", err)
+	}
+	descriptions := make(map[string]string)
+	for _, ruleInfos := range doc.Header.Rules {
+		for _, rule := range ruleInfos {
+			descriptions[rule.Name] = rule.Description
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s\n%s%s\n\n", headerDelimiter, header.String(), headerDelimiter)
+	fmt.Fprintf(&buf, "# %s\n\n", doc.ControlID)
+	writeSection(&buf, doc.ControlID, doc.Prose, doc.Rules, descriptions)
+	for _, statement := range doc.Statements {
+		writeSection(&buf, statementPrefix+statement.StatementID, statement.Prose, statement.Rules, descriptions)
+	}
+	if _, err := w.Write(bytes.TrimRight(buf.Bytes(), "\n")); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func writeSection(buf *bytes.Buffer, name, prose string, ruleIds []string, descriptions map[string]string) {
+	fmt.Fprintf(buf, "%s%s\n\n", implementationHeading, name)
+	if prose = strings.TrimSpace(prose); prose != "" {
+		fmt.Fprintf(buf, "%s\n\n", prose)
+	}
+	if len(ruleIds) == 0 {
+		return
+	}
+	fmt.Fprintf(buf, "%s\n\n| Rule | Description |\n|------|-------------|\n", rulesHeading)
+	for _, ruleId := range ruleIds {
+		fmt.Fprintf(buf, "| %s | %s |\n", ruleId, strings.ReplaceAll(descriptions[ruleId], "|", `\|`))
+	}
+	buf.WriteString("\n")
+}
+
+// ReadMarkdown reads a ControlMarkdown from a Markdown document with a YAML header.
+// Rules are read from the first column of the rule tables.
+func ReadMarkdown(r io.Reader) (ControlMarkdown, error) {
+	var doc ControlMarkdown
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return doc, err
+	}
+	body := string(content)
+	if rest, found := strings.CutPrefix(body, headerDelimiter+"\n"); found {
+		header, remaining, found := strings.Cut(rest, "\n"+headerDelimiter+"\n")
+		if !found {
+			return doc, errors.New("markdown header is not terminated")
+		}
+		if err := yaml.Unmarshal([]byte(header), &doc.Header); err != nil {
+			return doc, fmt.Errorf("failed to parse markdown header: %w", err)
+		}
+		body = remaining
+	}
+
+	var prose *string
+	var ruleList *[]string
+	var proseLines []string
+	inRules, inTable := false, false
+	flush := func() {
+		if prose != nil {
+			*prose = strings.TrimSpace(strings.Join(proseLines, "\n"))
+		}
+		proseLines = nil
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if inRules {
+			// The rule table ends at the first line that is not a table row and
+			// any following lines are read as prose.
+			trimmed := strings.TrimSpace(line)
+			if strings.HasPrefix(trimmed, "|") {
+				inTable = true
+				if ruleId, ok := tableRuleId(line); ok && ruleList != nil {
+					*ruleList = append(*ruleList, ruleId)
+				}
+				continue
+			}
+			if trimmed == "" && !inTable {
+				continue
+			}
+			inRules = false
+		}
+		switch {
+		case strings.HasPrefix(line, "# "):
+			doc.ControlID = strings.TrimSpace(strings.TrimPrefix(line, "# "))
+		case strings.HasPrefix(line, implementationHeading):
+			flush()
+			name := strings.TrimSpace(strings.TrimPrefix(line, implementationHeading))
+			if statementId, found := strings.CutPrefix(name, statementPrefix); found {
+				doc.Statements = append(doc.Statements, StatementMarkdown{StatementID: statementId})
+				statement := &doc.Statements[len(doc.Statements)-1]
+				prose, ruleList = &statement.Prose, &statement.Rules
+			} else {
+				prose, ruleList = &doc.Prose, &doc.Rules
+			}
+		case strings.TrimSpace(line) == rulesHeading:
+			inRules, inTable = true, false
+		case prose != nil:
+			proseLines = append(proseLines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return doc, err
+	}
+	flush()
+
+	if doc.ControlID == "" {
+		return doc, ErrMissingControl
+	}
+	return doc, nil
+}
+
+// tableRuleId returns the rule id in the first column of a rule table row.
+func tableRuleId(line string) (string, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "|") {
+		return "", false
+	}
+	cells := strings.Split(strings.Trim(line, "|"), "|")
+	ruleId := strings.TrimSpace(cells[0])
+	if ruleId == "" || ruleId == "Rule" || strings.Trim(ruleId, "-: ") == "" {
+		return "", false
+	}
+	return ruleId, true
+}
+
+// ruleIds returns the values of the Rule_Id properties.
+func ruleIds(props []oscalTypes.Property) []string {
+	var ids []string
+	for _, prop := range extensions.FindAllProps(props, extensions.WithName(extensions.RuleIdProp)) {
+		ids = append(ids, prop.Value)
+	}
+	return ids
+}
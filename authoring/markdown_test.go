@@ -0,0 +1,254 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package authoring
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+func TestGenerateMarkdown(t *testing.T) {
+	component := testComponent(t)
+	docs, err := GenerateMarkdown(component)
+	require.NoError(t, err)
+	require.Len(t, docs, 1)
+
+	doc := docs[0]
+	require.Equal(t, "CIS-2.1", doc.ControlID)
+	require.Equal(t, []string{"etcd_cert_file", "etcd_key_file"}, doc.Rules)
+	require.Equal(t, []StatementMarkdown{{StatementID: "CIS-2.1_smt"}}, doc.Statements)
+	require.Equal(t, "profiles/cis/profile.json", doc.Header.Global.Source)
+	require.Equal(t, map[string][]ParameterInfo{
+		"TestKubernetes": {{Name: "file_name", Description: "A parameter for a file name", RuleID: "etcd_key_file"}},
+	}, doc.Header.Parameters)
+	require.Equal(t, map[string][]ParameterValueInfo{
+		"TestKubernetes": {{Name: "file_name", Values: []string{"file_name_override"}}},
+	}, doc.Header.ParameterValues)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteMarkdown(&buf, doc))
+	require.Contains(t, buf.String(), "# CIS-2.1\n")
+	require.Contains(t, buf.String(), "| etcd_key_file | Ensure that the --key-file argument is set as appropriate |\n")
+	require.Contains(t, buf.String(), "## Implementation for part CIS-2.1_smt")
+}
+
+func TestReadMarkdown(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantDoc ControlMarkdown
+		wantErr string
+	}{
+		{
+			name: "Valid/Document",
+			input: `---
+x-trestle-global:
+  sort-id: ac-01
+  source: profile.json
+x-custom-field:
+  key: value
+---
+
+# ac-1
+
+## Implementation for ac-1
+
+The component implements the policy.
+
+It is reviewed yearly.
+
+### Rules
+
+| Rule | Description |
+|------|-------------|
+| rule-1 | Rule 1 |
+
+## Implementation for part ac-1_smt.a
+
+Statement prose.
+
+### Rules
+
+| Rule | Description |
+|------|-------------|
+| rule-2 | |
+`,
+			wantDoc: ControlMarkdown{
+				Header: Header{
+					Global: GlobalInfo{SortID: "ac-01", Source: "profile.json"},
+					Extra:  map[string]any{"x-custom-field": map[string]any{"key": "value"}},
+				},
+				ControlID: "ac-1",
+				Prose:     "The component implements the policy.\n\nIt is reviewed yearly.",
+				Rules:     []string{"rule-1"},
+				Statements: []StatementMarkdown{
+					{StatementID: "ac-1_smt.a", Prose: "Statement prose.", Rules: []string{"rule-2"}},
+				},
+			},
+		},
+		{
+			name: "Valid/ProseAfterRules",
+			input: `# ac-1
+
+## Implementation for ac-1
+
+### Rules
+
+| Rule | Description |
+|------|-------------|
+| rule-1 | Rule 1 |
+
+The component implements the policy.
+| rule-2 | Not a rule table row |
+`,
+			wantDoc: ControlMarkdown{
+				ControlID: "ac-1",
+				Prose:     "The component implements the policy.\n| rule-2 | Not a rule table row |",
+				Rules:     []string{"rule-1"},
+			},
+		},
+		{
+			name:    "Invalid/MissingControl",
+			input:   "## Implementation for ac-1\n",
+			wantErr: ErrMissingControl.Error(),
+		},
+		{
+			name:    "Invalid/UnterminatedHeader",
+			input:   "---\nx-trestle-global: {}\n# ac-1\n",
+			wantErr: "markdown header is not terminated",
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			doc, err := ReadMarkdown(strings.NewReader(c.input))
+			if c.wantErr != "" {
+				require.EqualError(t, err, c.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.wantDoc, doc)
+		})
+	}
+}
+
+func TestAssembleMarkdown(t *testing.T) {
+	component := testComponent(t)
+	docs, err := GenerateMarkdown(component)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, WriteMarkdown(&buf, docs[0]))
+	generated := buf.String()
+
+	edited := strings.ReplaceAll(generated, "| etcd_cert_file | Ensure that the --cert-file argument is set as appropriate |\n", "")
+	edited = strings.ReplaceAll(edited, "- file_name_override", "- edited_file_name")
+	edited = strings.ReplaceAll(edited, "## Implementation for CIS-2.1\n", "## Implementation for CIS-2.1\n\nKey files are configured.\n")
+	edited += "\n## Implementation for part CIS-2.1_smt.a\n\nNew statement.\n\n### Rules\n\n| Rule | Description |\n|------|-------------|\n| new_rule | |\n"
+
+	tests := []struct {
+		name    string
+		input   string
+		source  string
+		assert  func(t *testing.T, original, assembled oscalTypes.ControlImplementationSet)
+		wantErr string
+	}{
+		{
+			name:  "Valid/Unchanged",
+			input: generated,
+			assert: func(t *testing.T, original, assembled oscalTypes.ControlImplementationSet) {
+				require.Equal(t, original, assembled)
+			},
+		},
+		{
+			name:  "Valid/Edited",
+			input: edited,
+			assert: func(t *testing.T, original, assembled oscalTypes.ControlImplementationSet) {
+				requirement := assembled.ImplementedRequirements[0]
+				require.Equal(t, original.ImplementedRequirements[0].UUID, requirement.UUID)
+				require.Equal(t, "Key files are configured.", requirement.Description)
+				require.Equal(t, []oscalTypes.Property{(*original.ImplementedRequirements[0].Props)[1]}, *requirement.Props)
+				require.Equal(t, []oscalTypes.SetParameter{{ParamId: "file_name", Values: []string{"edited_file_name"}}}, *requirement.SetParameters)
+				require.Equal(t, original.SetParameters, assembled.SetParameters)
+
+				statements := *requirement.Statements
+				require.Len(t, statements, 2)
+				require.Equal(t, (*original.ImplementedRequirements[0].Statements)[0], statements[0])
+				require.Equal(t, "CIS-2.1_smt.a", statements[1].StatementId)
+				require.Equal(t, "New statement.", statements[1].Description)
+				require.Equal(t, []oscalTypes.Property{
+					{Name: extensions.RuleIdProp, Value: "new_rule", Ns: extensions.TrestleNameSpace},
+				}, *statements[1].Props)
+			},
+		},
+		{
+			name:    "Invalid/SourceMismatch",
+			input:   generated,
+			source:  "other-profile.json",
+			wantErr: ErrSourceMismatch.Error(),
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			original := (*testComponent(t).ControlImplementations)[0]
+			assembled := (*testComponent(t).ControlImplementations)[0]
+			if c.source != "" {
+				assembled.Source = c.source
+			}
+			doc, err := ReadMarkdown(strings.NewReader(c.input))
+			require.NoError(t, err)
+
+			err = AssembleMarkdown(&assembled, doc)
+			if c.wantErr != "" {
+				require.ErrorContains(t, err, c.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			c.assert(t, original, assembled)
+		})
+	}
+}
+
+func TestAssembleMarkdown_MultipleDocuments(t *testing.T) {
+	docs, err := GenerateMarkdown(testComponent(t))
+	require.NoError(t, err)
+	edited := docs[0]
+	edited.Header.ParameterValues = map[string][]ParameterValueInfo{
+		"TestKubernetes": {{Name: "file_name", Values: []string{"edited_file_name"}}},
+	}
+	// An unchanged document for another control must not overwrite the edited value.
+	unchanged := docs[0]
+	unchanged.ControlID = "CIS-2.2"
+	unchanged.Statements = nil
+
+	original := (*testComponent(t).ControlImplementations)[0]
+	assembled := (*testComponent(t).ControlImplementations)[0]
+	require.NoError(t, AssembleMarkdown(&assembled, edited, unchanged))
+
+	require.Equal(t, original.SetParameters, assembled.SetParameters)
+	require.Len(t, assembled.ImplementedRequirements, 2)
+	require.Equal(t, []oscalTypes.SetParameter{{ParamId: "file_name", Values: []string{"edited_file_name"}}}, *assembled.ImplementedRequirements[0].SetParameters)
+	require.Equal(t, "CIS-2.2", assembled.ImplementedRequirements[1].ControlId)
+	require.Nil(t, assembled.ImplementedRequirements[1].SetParameters)
+}
+
+func testComponent(t *testing.T) oscalTypes.DefinedComponent {
+	file, err := os.Open("../testdata/component-definition-test.json")
+	require.NoError(t, err)
+	defer file.Close()
+	definition, err := models.NewComponentDefinition(file, validation.NoopValidator{})
+	require.NoError(t, err)
+	return (*definition.Components)[0]
+}
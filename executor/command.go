@@ -0,0 +1,156 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package executor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+)
+
+// Request defines the JSON document written to the standard input of a plugin subprocess.
+type Request struct {
+	// RuleSet is the rule and checks to run with the selected parameter values.
+	RuleSet RuleSetData `json:"ruleSet"`
+}
+
+// RuleSetData defines the JSON representation of an extensions.RuleSet in a Request.
+type RuleSetData struct {
+	Rule   RuleData    `json:"rule"`
+	Checks []CheckData `json:"checks,omitempty"`
+}
+
+// RuleData defines the JSON representation of an extensions.Rule in a Request.
+type RuleData struct {
+	ID          string          `json:"id"`
+	Description string          `json:"description,omitempty"`
+	Parameters  []ParameterData `json:"parameters,omitempty"`
+}
+
+// CheckData defines the JSON representation of an extensions.Check in a Request.
+type CheckData struct {
+	ID          string `json:"id"`
+	Description string `json:"description,omitempty"`
+}
+
+// ParameterData defines the JSON representation of an extensions.Parameter in a Request.
+type ParameterData struct {
+	ID          string `json:"id"`
+	Description string `json:"description,omitempty"`
+	Value       string `json:"value,omitempty"`
+}
+
+// Response defines the JSON document a plugin subprocess writes to standard output.
+type Response struct {
+	// Observations are the Observations for the checks that were run.
+	Observations []oscalTypes.Observation `json:"observations,omitempty"`
+	// Error is set when the checks could not be run.
+	Error string `json:"error,omitempty"`
+}
+
+// CommandExecutor is an Executor that runs checks with a local plugin subprocess. The Request is written to
+// the standard input of the subprocess and the Response is read from the standard output.
+type CommandExecutor struct {
+	path string
+	args []string
+}
+
+// NewCommandExecutor returns a CommandExecutor for the plugin at the given path.
+func NewCommandExecutor(path string, args ...string) *CommandExecutor {
+	return &CommandExecutor{
+		path: path,
+		args: args,
+	}
+}
+
+// Execute runs the plugin subprocess for a RuleSet.
+func (c *CommandExecutor) Execute(ctx context.Context, ruleSet extensions.RuleSet) ([]oscalTypes.Observation, error) {
+	request, err := json.Marshal(Request{RuleSet: newRuleSetData(ruleSet)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode plugin request: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.path, c.args...)
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("plugin %s failed: %w: %s", c.path, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var response Response
+	if err := json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return nil, fmt.Errorf("failed to decode plugin %s response: %w", c.path, err)
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("plugin %s: %s", c.path, response.Error)
+	}
+	return response.Observations, nil
+}
+
+// Serve implements the plugin side of the subprocess protocol. It reads a Request from the input, runs the Executor,
+// and writes the Response to the output. Errors from the Executor are returned to the caller in the Response.
+func Serve(ctx context.Context, executor Executor, in io.Reader, out io.Writer) error {
+	var request Request
+	if err := json.NewDecoder(in).Decode(&request); err != nil {
+		return fmt.Errorf("failed to decode plugin request: %w", err)
+	}
+
+	var response Response
+	observations, err := executor.Execute(ctx, request.RuleSet.ruleSet())
+	if err != nil {
+		response.Error = err.Error()
+	} else {
+		response.Observations = observations
+	}
+	if err := json.NewEncoder(out).Encode(response); err != nil {
+		return fmt.Errorf("failed to encode plugin response: %w", err)
+	}
+	return nil
+}
+
+// newRuleSetData returns the Request representation of a RuleSet.
+func newRuleSetData(ruleSet extensions.RuleSet) RuleSetData {
+	data := RuleSetData{
+		Rule: RuleData{
+			ID:          ruleSet.Rule.ID,
+			Description: ruleSet.Rule.Description,
+		},
+	}
+	for _, parameter := range ruleSet.Rule.Parameters {
+		data.Rule.Parameters = append(data.Rule.Parameters, ParameterData(parameter))
+	}
+	for _, check := range ruleSet.Checks {
+		data.Checks = append(data.Checks, CheckData(check))
+	}
+	return data
+}
+
+// ruleSet returns the RuleSet from the Request representation.
+func (r RuleSetData) ruleSet() extensions.RuleSet {
+	ruleSet := extensions.RuleSet{
+		Rule: extensions.Rule{
+			ID:          r.Rule.ID,
+			Description: r.Rule.Description,
+		},
+	}
+	for _, parameter := range r.Rule.Parameters {
+		ruleSet.Rule.Parameters = append(ruleSet.Rule.Parameters, extensions.Parameter(parameter))
+	}
+	for _, check := range r.Checks {
+		ruleSet.Checks = append(ruleSet.Checks, extensions.Check(check))
+	}
+	return ruleSet
+}
@@ -0,0 +1,97 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+)
+
+const helperProcessEnv = "EXECUTOR_HELPER_PROCESS"
+
+// TestHelperProcess is not a real test. It is run as a plugin subprocess
+// by the CommandExecutor tests.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv(helperProcessEnv) == "" {
+		t.Skip("helper process for plugin tests")
+	}
+	plugin := ExecutorFunc(func(_ context.Context, ruleSet extensions.RuleSet) ([]oscalTypes.Observation, error) {
+		if len(ruleSet.Rule.Parameters) == 0 {
+			return nil, errors.New("missing parameters")
+		}
+		var observations []oscalTypes.Observation
+		for _, check := range ruleSet.Checks {
+			result := extensions.ResultFail
+			if ruleSet.Rule.Parameters[0].Value == "expected" {
+				result = extensions.ResultPass
+			}
+			observations = append(observations, NewObservation(ruleSet, check.ID, result, ""))
+		}
+		return observations, nil
+	})
+	if err := Serve(context.Background(), plugin, os.Stdin, os.Stdout); err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func TestCommandExecutor(t *testing.T) {
+	t.Setenv(helperProcessEnv, "1")
+	plugin := NewCommandExecutor(os.Args[0], "-test.run=TestHelperProcess")
+
+	ruleSet := extensions.RuleSet{
+		Rule: extensions.Rule{
+			ID:         "rule-1",
+			Parameters: []extensions.Parameter{{ID: "param-1", Value: "expected"}},
+		},
+		Checks: []extensions.Check{{ID: "check-1"}, {ID: "check-2"}},
+	}
+	observations, err := plugin.Execute(context.Background(), ruleSet)
+	require.NoError(t, err)
+	require.Len(t, observations, 2)
+	require.Equal(t, "check-2", observations[1].Title)
+	result, found := results.ObservationResult(observations[0])
+	require.True(t, found)
+	require.Equal(t, extensions.ResultPass, result)
+
+	ruleSet.Rule.Parameters = nil
+	_, err = plugin.Execute(context.Background(), ruleSet)
+	require.ErrorContains(t, err, "missing parameters")
+
+	_, err = NewCommandExecutor("./does-not-exist").Execute(context.Background(), ruleSet)
+	require.ErrorContains(t, err, "plugin ./does-not-exist failed")
+}
+
+func TestRequest_JSON(t *testing.T) {
+	ruleSet := extensions.RuleSet{
+		Rule: extensions.Rule{
+			ID:         "rule-1",
+			Parameters: []extensions.Parameter{{ID: "param-1", Value: "expected"}},
+		},
+		Checks: []extensions.Check{{ID: "check-1", Description: "Check 1"}},
+	}
+	encoded, err := json.Marshal(Request{RuleSet: newRuleSetData(ruleSet)})
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"ruleSet": {
+			"rule": {"id": "rule-1", "parameters": [{"id": "param-1", "value": "expected"}]},
+			"checks": [{"id": "check-1", "description": "Check 1"}]
+		}
+	}`, string(encoded))
+
+	var request Request
+	require.NoError(t, json.Unmarshal(encoded, &request))
+	require.Equal(t, ruleSet, request.RuleSet.ruleSet())
+}
@@ -0,0 +1,8 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package executor defines logic for running the checks in an OSCAL Assessment Plan with
+// registered plugins and collecting the outcomes as OSCAL Assessment Results.
+package executor
@@ -0,0 +1,107 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+)
+
+// ErrCheckRegistered defines an error returned when an Executor is registered
+// for a check id that already has an Executor.
+var ErrCheckRegistered = errors.New("executor already registered for check")
+
+// Executor runs the checks for a rule.
+type Executor interface {
+	// Execute runs the checks in the RuleSet and returns an Observation for each check. The RuleSet
+	// parameters have the values selected in the Assessment Plan.
+	Execute(ctx context.Context, ruleSet extensions.RuleSet) ([]oscalTypes.Observation, error)
+}
+
+// ExecutorFunc is an adapter to allow the use of ordinary functions as an Executor.
+type ExecutorFunc func(ctx context.Context, ruleSet extensions.RuleSet) ([]oscalTypes.Observation, error)
+
+// Execute calls f(ctx, ruleSet).
+func (f ExecutorFunc) Execute(ctx context.Context, ruleSet extensions.RuleSet) ([]oscalTypes.Observation, error) {
+	return f(ctx, ruleSet)
+}
+
+// Registry stores Executors by the check ids they implement.
+type Registry struct {
+	executors []Executor
+	byCheck   map[string]int
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		byCheck: make(map[string]int),
+	}
+}
+
+// Register registers an Executor for the given check ids.
+func (r *Registry) Register(executor Executor, checkIds ...string) error {
+	for _, checkId := range checkIds {
+		if _, ok := r.byCheck[checkId]; ok {
+			return fmt.Errorf("%w: %s", ErrCheckRegistered, checkId)
+		}
+	}
+	r.executors = append(r.executors, executor)
+	for _, checkId := range checkIds {
+		r.byCheck[checkId] = len(r.executors) - 1
+	}
+	return nil
+}
+
+// Lookup returns the Executor registered for a check id.
+func (r *Registry) Lookup(checkId string) (Executor, bool) {
+	idx, ok := r.byCheck[checkId]
+	if !ok {
+		return nil, false
+	}
+	return r.executors[idx], true
+}
+
+// NewObservation returns an Observation for a check in a RuleSet with the given result and reason. Executors can use
+// this to create Observations that are matched to the Assessment Plan activities. Use `WithUUIDFunc` and `WithClock`
+// to set the source of the Observation UUID and collection time.
+func NewObservation(ruleSet extensions.RuleSet, checkId string, result extensions.Result, reason string, opts ...RunOption) oscalTypes.Observation {
+	options := runOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return newObservation(ruleSet, checkId, result, reason, options)
+}
+
+func newObservation(ruleSet extensions.RuleSet, checkId string, result extensions.Result, reason string, options runOpts) oscalTypes.Observation {
+	observation := oscalTypes.Observation{
+		UUID:        options.uuid(fmt.Sprintf("observation/%s/%s", ruleSet.Rule.ID, checkId)),
+		Title:       checkId,
+		Description: fmt.Sprintf("Observation of check %q for rule %q", checkId, ruleSet.Rule.ID),
+		Collected:   options.clock(),
+		Props: &[]oscalTypes.Property{
+			{
+				Name:  extensions.AssessmentRuleIdProp,
+				Value: ruleSet.Rule.ID,
+				Ns:    extensions.TrestleNameSpace,
+			},
+			{
+				Name:  extensions.AssessmentCheckIdProp,
+				Value: checkId,
+				Ns:    extensions.TrestleNameSpace,
+			},
+		},
+	}
+	results.SetObservationResult(&observation, result, reason)
+	return observation
+}
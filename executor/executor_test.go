@@ -0,0 +1,58 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package executor
+
+import (
+	"context"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+)
+
+func TestRegistry(t *testing.T) {
+	noop := ExecutorFunc(func(_ context.Context, _ extensions.RuleSet) ([]oscalTypes.Observation, error) {
+		return nil, nil
+	})
+
+	registry := NewRegistry()
+	require.NoError(t, registry.Register(noop, "check-1", "check-2"))
+
+	_, found := registry.Lookup("check-1")
+	require.True(t, found)
+	_, found = registry.Lookup("check-3")
+	require.False(t, found)
+
+	err := registry.Register(noop, "check-3", "check-2")
+	require.ErrorIs(t, err, ErrCheckRegistered)
+	require.EqualError(t, err, "executor already registered for check: check-2")
+	// A failed registration should not register any checks
+	_, found = registry.Lookup("check-3")
+	require.False(t, found)
+}
+
+func TestNewObservation(t *testing.T) {
+	ruleSet := extensions.RuleSet{
+		Rule: extensions.Rule{ID: "rule-1"},
+	}
+	observation := NewObservation(ruleSet, "check-1", extensions.ResultFail, "not compliant")
+	require.Equal(t, "check-1", observation.Title)
+
+	rule, found := extensions.GetTrestleProp(extensions.AssessmentRuleIdProp, *observation.Props)
+	require.True(t, found)
+	require.Equal(t, "rule-1", rule.Value)
+	check, found := extensions.GetTrestleProp(extensions.AssessmentCheckIdProp, *observation.Props)
+	require.True(t, found)
+	require.Equal(t, "check-1", check.Value)
+
+	result, found := results.ObservationResult(observation)
+	require.True(t, found)
+	require.Equal(t, extensions.ResultFail, result)
+	require.Equal(t, "not compliant", results.ObservationReason(observation))
+}
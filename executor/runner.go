@@ -0,0 +1,298 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package executor
+
+import (
+	"context"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/plans"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/settings"
+)
+
+type runOpts struct {
+	title     string
+	importAP  string
+	uuid      models.UUIDFunc
+	clock     models.Clock
+	satisfied results.SatisfiedFunc
+}
+
+func (r *runOpts) defaults() {
+	r.title = models.SampleRequiredString
+	r.importAP = models.SampleRequiredString
+	r.uuid = models.RandomUUID
+	r.clock = models.SystemClock
+	r.satisfied = results.AllPassed
+}
+
+// RunOption defines an option to tune the behavior of the
+// Run function.
+type RunOption func(opts *runOpts)
+
+// WithTitle is a RunOption that sets the AssessmentResults title
+// in the metadata.
+func WithTitle(title string) RunOption {
+	return func(opts *runOpts) {
+		opts.title = title
+	}
+}
+
+// WithImport is a RunOption that sets the AssessmentResults
+// ImportAP Href value.
+func WithImport(importAP string) RunOption {
+	return func(opts *runOpts) {
+		opts.importAP = importAP
+	}
+}
+
+// WithUUIDFunc is a RunOption that sets the source of UUIDs for the AssessmentResults and the
+// Observations created by NewObservation. Use models.ContentUUID to generate the same UUIDs for the same inputs.
+func WithUUIDFunc(uuidFunc models.UUIDFunc) RunOption {
+	return func(opts *runOpts) {
+		opts.uuid = uuidFunc
+	}
+}
+
+// WithClock is a RunOption that sets the source of timestamps for the AssessmentResults and
+// the Observations created by NewObservation.
+func WithClock(clock models.Clock) RunOption {
+	return func(opts *runOpts) {
+		opts.clock = clock
+	}
+}
+
+// WithSatisfiedFunc is a RunOption that sets the logic used to determine
+// whether a control is satisfied in the AssessmentResults Findings.
+func WithSatisfiedFunc(satisfied func(controlId string, observations []oscalTypes.Observation) bool) RunOption {
+	return func(opts *runOpts) {
+		opts.satisfied = satisfied
+	}
+}
+
+// Run runs the checks for the activities in an Assessment Plan with the Executors in the Registry and returns
+// Assessment Results.
+//
+// Each activity is run as a RuleSet with the activity title as the rule id, the test parameters as the rule parameters,
+// and the steps as the checks. Skipped activities are not run. Checks are grouped by Executor so each Executor is called
+// once per activity. If an Executor returns an error, an Observation with an error result is recorded for each of the checks.
+// Checks without a registered Executor have empty Observations in the Assessment Results.
+//
+// An activity with different parameter values for different controls is run once per distinct set of values, and the
+// Observations for each check are combined, so a check failing with any of the values fails for all the activity controls.
+func Run(ctx context.Context, plan oscalTypes.AssessmentPlan, registry *Registry, opts ...RunOption) (*oscalTypes.AssessmentResults, error) {
+	options := runOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var observations []oscalTypes.Observation
+	if plan.LocalDefinitions != nil && plan.LocalDefinitions.Activities != nil {
+		for _, activity := range *plan.LocalDefinitions.Activities {
+			activitySettings := settings.NewAssessmentActivitiesSettings([]oscalTypes.Activity{activity})
+			if !activitySettings.ContainsRule(activity.Title) {
+				continue
+			}
+			activityObservations, err := runActivity(ctx, activity, registry, options)
+			if err != nil {
+				return nil, err
+			}
+			observations = append(observations, activityObservations...)
+		}
+	}
+
+	generateOpts := []results.GenerateOption{
+		results.WithTitle(options.title),
+		results.WithImport(options.importAP),
+		results.WithUUIDFunc(options.uuid),
+		results.WithClock(options.clock),
+		results.WithSatisfiedFunc(options.satisfied),
+	}
+	if len(observations) > 0 {
+		generateOpts = append(generateOpts, results.WithObservations(observations))
+	}
+	return results.GenerateAssessmentResults(plan, generateOpts...)
+}
+
+// runActivity runs the checks for a single activity grouped by Executor. The checks are run once for each
+// distinct set of parameter values in the activity related controls.
+func runActivity(ctx context.Context, activity oscalTypes.Activity, registry *Registry, options runOpts) ([]oscalTypes.Observation, error) {
+	var observations []oscalTypes.Observation
+	ruleSets := ruleSetsForActivity(activity)
+	for _, ruleSet := range ruleSets {
+		ruleSetObservations, err := runRuleSet(ctx, ruleSet, registry, options)
+		if err != nil {
+			return nil, err
+		}
+		observations = append(observations, ruleSetObservations...)
+	}
+	if len(ruleSets) > 1 {
+		observations = mergeObservations(observations)
+	}
+	return observations, nil
+}
+
+// runRuleSet runs the checks in a RuleSet grouped by Executor.
+func runRuleSet(ctx context.Context, ruleSet extensions.RuleSet, registry *Registry, options runOpts) ([]oscalTypes.Observation, error) {
+	var executorOrder []int
+	checksByExecutor := make(map[int][]extensions.Check)
+	for _, check := range ruleSet.Checks {
+		idx, ok := registry.byCheck[check.ID]
+		if !ok {
+			continue
+		}
+		if _, seen := checksByExecutor[idx]; !seen {
+			executorOrder = append(executorOrder, idx)
+		}
+		checksByExecutor[idx] = append(checksByExecutor[idx], check)
+	}
+
+	var observations []oscalTypes.Observation
+	for _, idx := range executorOrder {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		executorRuleSet := extensions.RuleSet{
+			Rule:   ruleSet.Rule,
+			Checks: checksByExecutor[idx],
+		}
+		executorObservations, err := registry.executors[idx].Execute(ctx, executorRuleSet)
+		if err != nil {
+			for _, check := range executorRuleSet.Checks {
+				observations = append(observations, newObservation(executorRuleSet, check.ID, extensions.ResultError, err.Error(), options))
+			}
+			continue
+		}
+		for _, observation := range executorObservations {
+			observations = append(observations, withAssessmentProps(observation, executorRuleSet))
+		}
+	}
+	return observations, nil
+}
+
+// ruleSetsForActivity returns a RuleSet for each distinct set of parameter values
+// selected for the controls in an activity.
+func ruleSetsForActivity(activity oscalTypes.Activity) []extensions.RuleSet {
+	var checks []extensions.Check
+	if activity.Steps != nil {
+		for _, step := range *activity.Steps {
+			checks = append(checks, extensions.Check{
+				ID:          step.Title,
+				Description: step.Description,
+			})
+		}
+	}
+
+	var ruleSets []extensions.RuleSet
+	for _, group := range plans.ActivityParameters(activity) {
+		ruleSets = append(ruleSets, extensions.RuleSet{
+			Rule: extensions.Rule{
+				ID:          activity.Title,
+				Description: activity.Description,
+				Parameters:  group.Parameters,
+			},
+			Checks: checks,
+		})
+	}
+	return ruleSets
+}
+
+// mergeObservations combines the Observations for the same check from runs with different parameter values,
+// so each check has a single Observation. The subjects and relevant evidence are combined, and a result set on
+// the Observations is aggregated with a failure taking precedence over an error, and an error over a pass.
+func mergeObservations(observations []oscalTypes.Observation) []oscalTypes.Observation {
+	var merged []oscalTypes.Observation
+	indexByCheck := make(map[string]int)
+	for _, observation := range observations {
+		checkId := observation.Title
+		if observation.Props != nil {
+			if check, found := extensions.GetTrestleProp(extensions.AssessmentCheckIdProp, *observation.Props); found {
+				checkId = check.Value
+			}
+		}
+		idx, ok := indexByCheck[checkId]
+		if !ok {
+			indexByCheck[checkId] = len(merged)
+			merged = append(merged, observation)
+			continue
+		}
+
+		existing := &merged[idx]
+		if hasObservationResult(*existing) || hasObservationResult(observation) {
+			var aggregated []extensions.Result
+			var reasons []string
+			for _, candidate := range []oscalTypes.Observation{*existing, observation} {
+				if result, found := results.ObservationResult(candidate); found {
+					aggregated = append(aggregated, result)
+				}
+				if reason := results.ObservationReason(candidate); reason != "" {
+					reasons = append(reasons, reason)
+				}
+			}
+			results.SetObservationResult(existing, results.AggregateResults(aggregated...), strings.Join(reasons, "\n"))
+		}
+		if observation.Subjects != nil {
+			var subjects []oscalTypes.SubjectReference
+			if existing.Subjects != nil {
+				subjects = append(subjects, *existing.Subjects...)
+			}
+			subjects = append(subjects, *observation.Subjects...)
+			existing.Subjects = &subjects
+		}
+		if observation.RelevantEvidence != nil {
+			var evidence []oscalTypes.RelevantEvidence
+			if existing.RelevantEvidence != nil {
+				evidence = append(evidence, *existing.RelevantEvidence...)
+			}
+			evidence = append(evidence, *observation.RelevantEvidence...)
+			existing.RelevantEvidence = &evidence
+		}
+	}
+	return merged
+}
+
+// hasObservationResult returns whether a result is set on the Observation rather than its subjects.
+func hasObservationResult(observation oscalTypes.Observation) bool {
+	if observation.Props == nil {
+		return false
+	}
+	_, found := extensions.GetResult(*observation.Props)
+	return found
+}
+
+// withAssessmentProps adds the assessment rule and check properties to an Observation
+// if not set by the Executor. The check is only set when the RuleSet has a single check.
+func withAssessmentProps(observation oscalTypes.Observation, ruleSet extensions.RuleSet) oscalTypes.Observation {
+	var props []oscalTypes.Property
+	if observation.Props != nil {
+		props = *observation.Props
+	}
+	if _, found := extensions.GetTrestleProp(extensions.AssessmentRuleIdProp, props); !found {
+		props = append(props, oscalTypes.Property{
+			Name:  extensions.AssessmentRuleIdProp,
+			Value: ruleSet.Rule.ID,
+			Ns:    extensions.TrestleNameSpace,
+		})
+	}
+	if _, found := extensions.GetTrestleProp(extensions.AssessmentCheckIdProp, props); !found && len(ruleSet.Checks) == 1 {
+		props = append(props, oscalTypes.Property{
+			Name:  extensions.AssessmentCheckIdProp,
+			Value: ruleSet.Checks[0].ID,
+			Ns:    extensions.TrestleNameSpace,
+		})
+		if observation.Title == "" {
+			observation.Title = ruleSet.Checks[0].ID
+		}
+	}
+	observation.Props = &props
+	return observation
+}
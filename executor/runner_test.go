@@ -0,0 +1,181 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+func TestRun(t *testing.T) {
+	file, err := os.Open("../testdata/test-ap.json")
+	require.NoError(t, err)
+	defer file.Close()
+	plan, err := models.NewAssessmentPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		executor   Executor
+		wantResult extensions.Result
+		wantState  string
+	}{
+		{
+			name: "Valid/Passing",
+			executor: ExecutorFunc(func(_ context.Context, ruleSet extensions.RuleSet) ([]oscalTypes.Observation, error) {
+				require.Equal(t, "rule-1", ruleSet.Rule.ID)
+				require.Equal(t, []extensions.Parameter{{ID: "param-1"}}, ruleSet.Rule.Parameters)
+				require.Equal(t, []extensions.Check{{ID: "check-1", Description: "Check 1 Description"}}, ruleSet.Checks)
+				observation := oscalTypes.Observation{
+					UUID:      "11111111-1111-4111-8111-111111111111",
+					Collected: plan.Metadata.LastModified,
+				}
+				results.SetObservationResult(&observation, extensions.ResultPass, "")
+				return []oscalTypes.Observation{observation}, nil
+			}),
+			wantResult: extensions.ResultPass,
+			wantState:  results.StateSatisfied,
+		},
+		{
+			name: "Valid/ExecutorError",
+			executor: ExecutorFunc(func(_ context.Context, _ extensions.RuleSet) ([]oscalTypes.Observation, error) {
+				return nil, errors.New("cluster unreachable")
+			}),
+			wantResult: extensions.ResultError,
+			wantState:  results.StateNotSatisfied,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			registry := NewRegistry()
+			require.NoError(t, registry.Register(c.executor, "check-1"))
+
+			assessmentResults, err := Run(context.Background(), *plan, registry, WithTitle("mytitle"), WithImport("test-ap.json"))
+			require.NoError(t, err)
+			require.Equal(t, "mytitle", assessmentResults.Metadata.Title)
+			require.Equal(t, "test-ap.json", assessmentResults.ImportAp.Href)
+
+			require.Len(t, assessmentResults.Results, 1)
+			result := assessmentResults.Results[0]
+			require.Len(t, *result.Observations, 1)
+			observation := (*result.Observations)[0]
+			check, found := extensions.GetTrestleProp(extensions.AssessmentCheckIdProp, *observation.Props)
+			require.True(t, found)
+			require.Equal(t, "check-1", check.Value)
+			gotResult, found := results.ObservationResult(observation)
+			require.True(t, found)
+			require.Equal(t, c.wantResult, gotResult)
+
+			for _, finding := range *result.Findings {
+				require.Equal(t, c.wantState, finding.Target.Status.State)
+			}
+
+			validator := validation.NewSchemaValidator()
+			require.NoError(t, validator.Validate(oscalTypes.OscalModels{AssessmentResults: assessmentResults}))
+		})
+	}
+}
+
+func TestRun_ControlParameters(t *testing.T) {
+	file, err := os.Open("../testdata/test-ap.json")
+	require.NoError(t, err)
+	defer file.Close()
+	plan, err := models.NewAssessmentPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	// Tune rule-1 with a different parameter value for each control
+	selection := func(controlId, value string) oscalTypes.AssessedControls {
+		return oscalTypes.AssessedControls{
+			IncludeControls: &[]oscalTypes.AssessedControlsSelectControlById{{ControlId: controlId}},
+			Props: &[]oscalTypes.Property{
+				{Name: "param-1", Value: value, Ns: extensions.TrestleNameSpace, Class: extensions.TestParameterClass},
+			},
+		}
+	}
+	activities := *plan.LocalDefinitions.Activities
+	require.Equal(t, "rule-1", activities[0].Title)
+	activities[0].RelatedControls.ControlSelections = []oscalTypes.AssessedControls{
+		selection("ex-1", "lenient"),
+		selection("ex-2", "strict"),
+	}
+
+	var gotValues []string
+	executor := ExecutorFunc(func(_ context.Context, ruleSet extensions.RuleSet) ([]oscalTypes.Observation, error) {
+		require.Len(t, ruleSet.Rule.Parameters, 1)
+		value := ruleSet.Rule.Parameters[0].Value
+		gotValues = append(gotValues, value)
+		observation := oscalTypes.Observation{
+			UUID:      "11111111-1111-4111-8111-111111111111",
+			Collected: plan.Metadata.LastModified,
+		}
+		if value == "strict" {
+			results.SetObservationResult(&observation, extensions.ResultFail, "strict value failed")
+		} else {
+			results.SetObservationResult(&observation, extensions.ResultPass, "")
+		}
+		return []oscalTypes.Observation{observation}, nil
+	})
+	registry := NewRegistry()
+	require.NoError(t, registry.Register(executor, "check-1"))
+
+	assessmentResults, err := Run(context.Background(), *plan, registry)
+	require.NoError(t, err)
+	require.Equal(t, []string{"lenient", "strict"}, gotValues)
+
+	// The observations for each parameter value are combined
+	result := assessmentResults.Results[0]
+	require.Len(t, *result.Observations, 1)
+	observation := (*result.Observations)[0]
+	gotResult, found := results.ObservationResult(observation)
+	require.True(t, found)
+	require.Equal(t, extensions.ResultFail, gotResult)
+	require.Equal(t, "strict value failed", results.ObservationReason(observation))
+}
+
+func TestRun_Reproducible(t *testing.T) {
+	file, err := os.Open("../testdata/test-ap.json")
+	require.NoError(t, err)
+	defer file.Close()
+	plan, err := models.NewAssessmentPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	failing := ExecutorFunc(func(_ context.Context, _ extensions.RuleSet) ([]oscalTypes.Observation, error) {
+		return nil, errors.New("cluster unreachable")
+	})
+	registry := NewRegistry()
+	require.NoError(t, registry.Register(failing, "check-1"))
+
+	clock := models.FixedClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	run := func() *oscalTypes.AssessmentResults {
+		assessmentResults, err := Run(context.Background(), *plan, registry,
+			WithUUIDFunc(models.ContentUUID("test")),
+			WithClock(clock),
+			WithSatisfiedFunc(func(_ string, _ []oscalTypes.Observation) bool { return true }),
+		)
+		require.NoError(t, err)
+		return assessmentResults
+	}
+
+	first := run()
+	require.Equal(t, first, run())
+	result := first.Results[0]
+	require.True(t, clock().Equal((*result.Observations)[0].Collected))
+	for _, finding := range *result.Findings {
+		require.Equal(t, results.StateSatisfied, finding.Target.Status.State)
+	}
+}
@@ -45,6 +45,15 @@ const (
 	SkippedRulesProperty = "skipped"
 	// WaivedRulesProperty represents the property name for Waived Rules.
 	WaivedRulesProperty = "waived"
+	// ResultProp represents the property name for the result of a check on an OSCAL
+	// Observation or Observation subject.
+	ResultProp = "result"
+	// ReasonProp represents the property name for the reason of a check result on an OSCAL
+	// Observation or Observation subject.
+	ReasonProp = "reason"
+	// EvidenceRel represents the link relation for evidence supporting a check result on
+	// an OSCAL Observation subject.
+	EvidenceRel = "evidence"
 )
 
 type findOptions struct {
@@ -0,0 +1,80 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions
+
+import (
+	"fmt"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+)
+
+// Result defines the outcome of a check recorded on an OSCAL Observation
+// or Observation subject.
+type Result string
+
+const (
+	// ResultPass is the result for a check that passed.
+	ResultPass Result = "pass"
+	// ResultFail is the result for a check that failed.
+	ResultFail Result = "fail"
+	// ResultError is the result for a check that could not be completed.
+	ResultError Result = "error"
+	// ResultNotApplicable is the result for a check that does not apply to
+	// the subject.
+	ResultNotApplicable Result = "not-applicable"
+)
+
+// ParseResult returns the Result for a given property value. Values are matched
+// case-insensitively and "failure" is accepted as an alias of ResultFail.
+func ParseResult(value string) (Result, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case string(ResultPass):
+		return ResultPass, nil
+	case string(ResultFail), "failure":
+		return ResultFail, nil
+	case string(ResultError):
+		return ResultError, nil
+	case string(ResultNotApplicable):
+		return ResultNotApplicable, nil
+	default:
+		return "", fmt.Errorf("invalid result %q", value)
+	}
+}
+
+// NewResultProp returns a trestle property for a given Result.
+func NewResultProp(result Result) oscalTypes.Property {
+	return oscalTypes.Property{
+		Name:  ResultProp,
+		Value: string(result),
+		Ns:    TrestleNameSpace,
+	}
+}
+
+// NewReasonProp returns a trestle property for a given result reason.
+func NewReasonProp(reason string) oscalTypes.Property {
+	return oscalTypes.Property{
+		Name:  ReasonProp,
+		Value: reason,
+		Ns:    TrestleNameSpace,
+	}
+}
+
+// GetResult returns the first valid Result found in a set of properties.
+// Result properties without a namespace are also accepted for compatibility with
+// tools that do not set the trestle namespace.
+func GetResult(props []oscalTypes.Property) (Result, bool) {
+	for _, prop := range props {
+		if prop.Name != ResultProp || (prop.Ns != "" && !strings.Contains(prop.Ns, TrestleNameSpace)) {
+			continue
+		}
+		result, err := ParseResult(prop.Value)
+		if err == nil {
+			return result, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,112 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package extensions
+
+import (
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResult(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		wantResult Result
+		wantError  string
+	}{
+		{
+			name:       "Valid/Pass",
+			value:      "PASS",
+			wantResult: ResultPass,
+		},
+		{
+			name:       "Valid/FailureAlias",
+			value:      "failure",
+			wantResult: ResultFail,
+		},
+		{
+			name:       "Valid/NotApplicable",
+			value:      "not-applicable",
+			wantResult: ResultNotApplicable,
+		},
+		{
+			name:      "Invalid/UnknownResult",
+			value:     "skipped",
+			wantError: "invalid result \"skipped\"",
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			result, err := ParseResult(c.value)
+			if c.wantError != "" {
+				require.EqualError(t, err, c.wantError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, c.wantResult, result)
+			}
+		})
+	}
+}
+
+func TestGetResult(t *testing.T) {
+	tests := []struct {
+		name       string
+		inputProps []oscalTypes.Property
+		wantResult Result
+		wantFound  bool
+	}{
+		{
+			name:       "Valid/TrestleNamespace",
+			inputProps: []oscalTypes.Property{NewReasonProp("reason"), NewResultProp(ResultError)},
+			wantResult: ResultError,
+			wantFound:  true,
+		},
+		{
+			name: "Valid/NoNamespace",
+			inputProps: []oscalTypes.Property{
+				{
+					Name:  ResultProp,
+					Value: "fail",
+				},
+			},
+			wantResult: ResultFail,
+			wantFound:  true,
+		},
+		{
+			name: "Invalid/OtherNamespace",
+			inputProps: []oscalTypes.Property{
+				{
+					Name:  ResultProp,
+					Value: "fail",
+					Ns:    "https://example.com",
+				},
+			},
+			wantFound: false,
+		},
+		{
+			name: "Invalid/UnknownValue",
+			inputProps: []oscalTypes.Property{
+				{
+					Name:  ResultProp,
+					Value: "unknown",
+					Ns:    TrestleNameSpace,
+				},
+			},
+			wantFound: false,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			result, found := GetResult(c.inputProps)
+			require.Equal(t, c.wantFound, found)
+			require.Equal(t, c.wantResult, result)
+		})
+	}
+}
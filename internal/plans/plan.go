@@ -8,8 +8,9 @@ package plans
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
-	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 
 	"github.com/oscal-compass/oscal-sdk-go/extensions"
@@ -28,11 +29,17 @@ const (
 type generateOpts struct {
 	title     string
 	importSSP string
+	store     rules.Store
+	uuid      models.UUIDFunc
+	clock     models.Clock
+	org       *models.Organization
 }
 
 func (g *generateOpts) defaults() {
 	g.title = models.SampleRequiredString
 	g.importSSP = models.SampleRequiredString
+	g.uuid = models.RandomUUID
+	g.clock = models.SystemClock
 }
 
 // GenerateOption defines an option to tune the behavior of the
@@ -55,6 +62,39 @@ func WithImport(importSSP string) GenerateOption {
 	}
 }
 
+// WithRulesStore is a GenerateOption that sets a pre-populated rules.Store to
+// use for the AssessmentPlan instead of indexing the input components.
+// This allows a single rules index to be shared between plans.
+func WithRulesStore(store rules.Store) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.store = store
+	}
+}
+
+// WithUUIDFunc is a GenerateOption that sets the source of UUIDs for the
+// AssessmentPlan. Use models.ContentUUID to generate the same UUIDs for the same inputs.
+func WithUUIDFunc(uuidFunc models.UUIDFunc) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.uuid = uuidFunc
+	}
+}
+
+// WithClock is a GenerateOption that sets the source of timestamps for the
+// AssessmentPlan metadata.
+func WithClock(clock models.Clock) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.clock = clock
+	}
+}
+
+// WithOrganization is a GenerateOption that adds the organization generating the
+// AssessmentPlan to the metadata parties with the creator role.
+func WithOrganization(org models.Organization) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.org = &org
+	}
+}
+
 // GenerateAssessmentPlan generates an AssessmentPlan for a set of Components and ImplementationSettings. The chosen inputs allow an Assessment Plan to be generated from
 // a set of OSCAL ComponentDefinitions or a SystemSecurityPlan.
 //
@@ -66,16 +106,20 @@ func GenerateAssessmentPlan(ctx context.Context, comps []components.Component, i
 		opt(&options)
 	}
 
-	memoryStore := rules.NewMemoryStore()
-	if err := memoryStore.IndexAll(comps); err != nil {
-		return nil, fmt.Errorf("failed processing components for assessment plan %q: %w", options.title, err)
+	store := options.store
+	if store == nil {
+		memoryStore := rules.NewMemoryStore()
+		if err := memoryStore.IndexAll(comps); err != nil {
+			return nil, fmt.Errorf("failed processing components for assessment plan %q: %w", options.title, err)
+		}
+		store = memoryStore
 	}
 
 	var (
 		allActivities    []oscalTypes.Activity
 		subjectSelectors []oscalTypes.SelectSubjectById
 		localComponents  []components.Component
-		ruleBasedTask    = newTask()
+		ruleBasedTask    = newTask(options.uuid)
 	)
 
 	for _, comp := range comps {
@@ -85,7 +129,7 @@ func GenerateAssessmentPlan(ctx context.Context, comps []components.Component, i
 			continue
 		}
 		compTitle := comp.Title()
-		componentActivities, err := ActivitiesForComponent(ctx, compTitle, memoryStore, implementationSettings)
+		componentActivities, err := activitiesForComponent(ctx, compTitle, store, implementationSettings, options.uuid)
 		if err != nil {
 			return nil, fmt.Errorf("error generating assessment activities for component %s: %w", compTitle, err)
 		}
@@ -115,7 +159,7 @@ func GenerateAssessmentPlan(ctx context.Context, comps []components.Component, i
 		}
 	}
 
-	assessmentAssets := AssessmentAssets(comps)
+	assessmentAssets := assessmentAssets(comps, options.uuid)
 	taskSubjects := oscalTypes.AssessmentSubject{
 		IncludeSubjects: &subjectSelectors,
 		Type:            defaultSubjectType,
@@ -124,9 +168,13 @@ func GenerateAssessmentPlan(ctx context.Context, comps []components.Component, i
 
 	metadata := models.NewSampleMetadata()
 	metadata.Title = options.title
+	metadata.LastModified = options.clock()
+	if options.org != nil {
+		models.SetOrganization(&metadata, *options.org)
+	}
 
 	assessmentPlan := &oscalTypes.AssessmentPlan{
-		UUID: uuid.NewUUID(),
+		UUID: options.uuid("assessment-plan"),
 		ImportSsp: oscalTypes.ImportSsp{
 			Href: options.importSSP,
 		},
@@ -147,9 +195,9 @@ func GenerateAssessmentPlan(ctx context.Context, comps []components.Component, i
 }
 
 // newTask creates a new OSCAL Task with default values.
-func newTask() oscalTypes.Task {
+func newTask(uuidFunc models.UUIDFunc) oscalTypes.Task {
 	return oscalTypes.Task{
-		UUID:                 uuid.NewUUID(),
+		UUID:                 uuidFunc("task/Automated Assessment"),
 		Title:                "Automated Assessment",
 		Type:                 defaultTaskType,
 		Description:          "Evaluation of defined rules for components.",
@@ -165,7 +213,15 @@ func newTask() oscalTypes.Task {
 // ID -> Title
 // Parameter -> Activity Property
 // Check -> Activity Step
+//
+// When a rule is tuned with different parameter values for different controls, the related controls
+// have a control selection per distinct set of values with the values set as control selection properties.
+// The activity properties have the values of the selection with the first control.
 func ActivitiesForComponent(ctx context.Context, targetComponentID string, store rules.Store, implementationSettings settings.ImplementationSettings) ([]oscalTypes.Activity, error) {
+	return activitiesForComponent(ctx, targetComponentID, store, implementationSettings, models.RandomUUID)
+}
+
+func activitiesForComponent(ctx context.Context, targetComponentID string, store rules.Store, implementationSettings settings.ImplementationSettings, uuidFunc models.UUIDFunc) ([]oscalTypes.Activity, error) {
 	methodProp := oscalTypes.Property{
 		Name:  "method",
 		Value: "TEST",
@@ -176,44 +232,201 @@ func ActivitiesForComponent(ctx context.Context, targetComponentID string, store
 		return nil, fmt.Errorf("error getting applied rules for component %s: %w", targetComponentID, err)
 	}
 
+	// Rules are returned in no particular order, so sort them to
+	// generate the same activities for the same inputs.
+	sort.Slice(appliedRules, func(i, j int) bool {
+		return appliedRules[i].Rule.ID < appliedRules[j].Rule.ID
+	})
+
 	var activities []oscalTypes.Activity
 	for _, rule := range appliedRules {
-		relatedControls, err := ReviewedControls(rule.Rule.ID, implementationSettings)
+		// A rule tuned differently for different controls has a control selection
+		// per distinct set of parameter values.
+		parameterGroups, err := groupByParameters(rule, implementationSettings)
 		if err != nil {
 			return nil, err
 		}
+		if len(parameterGroups) == 0 {
+			continue
+		}
+		// The parameter values of the first group are the activity defaults.
+		ruleSet := parameterGroups[0].ruleSet
 
 		var steps []oscalTypes.Step
-		for _, check := range rule.Checks {
+		for _, check := range ruleSet.Checks {
 			checkStep := oscalTypes.Step{
-				UUID:        uuid.NewUUID(),
+				UUID:        uuidFunc(fmt.Sprintf("step/%s/%s/%s", targetComponentID, ruleSet.Rule.ID, check.ID)),
 				Title:       check.ID,
 				Description: check.Description,
 			}
 			steps = append(steps, checkStep)
 		}
 
+		relatedControls := oscalTypes.ReviewedControls{}
+		for _, group := range parameterGroups {
+			controls := group.controls
+			selection := oscalTypes.AssessedControls{
+				IncludeControls: &controls,
+			}
+			if len(parameterGroups) > 1 {
+				parameterProps := parameterProperties(group.ruleSet.Rule.Parameters)
+				selection.Props = modelutils.NilIfEmpty(&parameterProps)
+			}
+			relatedControls.ControlSelections = append(relatedControls.ControlSelections, selection)
+		}
+
+		activityProps := append([]oscalTypes.Property{methodProp}, parameterProperties(ruleSet.Rule.Parameters)...)
 		activity := oscalTypes.Activity{
-			UUID:            uuid.NewUUID(),
-			Description:     rule.Rule.Description,
-			Props:           &[]oscalTypes.Property{methodProp},
+			UUID:            uuidFunc(fmt.Sprintf("activity/%s/%s", targetComponentID, ruleSet.Rule.ID)),
+			Description:     ruleSet.Rule.Description,
+			Props:           &activityProps,
 			RelatedControls: &relatedControls,
-			Title:           rule.Rule.ID,
+			Title:           ruleSet.Rule.ID,
 			Steps:           modelutils.NilIfEmpty(&steps),
 		}
+		activities = append(activities, activity)
+	}
+	return activities, nil
+}
+
+// parameterGroup defines a RuleSet with resolved parameter values and the
+// controls that share those values.
+type parameterGroup struct {
+	ruleSet  extensions.RuleSet
+	controls []oscalTypes.AssessedControlsSelectControlById
+}
+
+// groupByParameters returns the applicable controls for a RuleSet grouped by the parameter values resolved
+// for the rule in each control. Groups are returned in order of the first control ID in each group.
+func groupByParameters(ruleSet extensions.RuleSet, implementationSettings settings.ImplementationSettings) ([]parameterGroup, error) {
+	applicableControls, err := implementationSettings.ApplicableControls(ruleSet.Rule.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting applicable controls for rule %s: %w", ruleSet.Rule.ID, err)
+	}
+	sort.Slice(applicableControls, func(i, j int) bool {
+		return applicableControls[i].ControlId < applicableControls[j].ControlId
+	})
+
+	var groups []parameterGroup
+	groupIndex := make(map[string]int)
+	for _, control := range applicableControls {
+		controlSettings, err := implementationSettings.ApplicableSettings(ruleSet.Rule.ID, control.ControlId)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving settings for rule %s: %w", ruleSet.Rule.ID, err)
+		}
+		resolvedRuleSet := controlSettings.ApplyParameterSettings(ruleSet)
+		key := parameterKey(resolvedRuleSet.Rule.Parameters)
+		idx, ok := groupIndex[key]
+		if !ok {
+			groupIndex[key] = len(groups)
+			groups = append(groups, parameterGroup{ruleSet: resolvedRuleSet})
+			idx = len(groups) - 1
+		}
+		groups[idx].controls = append(groups[idx].controls, control)
+	}
+	return groups, nil
+}
+
+// parameterProperties returns the test parameter properties for the given rule parameters.
+func parameterProperties(parameters []extensions.Parameter) []oscalTypes.Property {
+	var props []oscalTypes.Property
+	for _, rp := range parameters {
+		props = append(props, oscalTypes.Property{
+			Name:  rp.ID,
+			Value: rp.Value,
+			Ns:    extensions.TrestleNameSpace,
+			Class: extensions.TestParameterClass,
+		})
+	}
+	return props
+}
+
+// ControlParameters defines the test parameter values for a rule in a set of controls.
+type ControlParameters struct {
+	// ControlIds are the controls using the parameter values.
+	ControlIds []string
+	// Parameters are the test parameter values.
+	Parameters []extensions.Parameter
+}
 
-		for _, rp := range rule.Rule.Parameters {
-			parameterProp := oscalTypes.Property{
-				Name:  rp.ID,
-				Value: rp.Value,
-				Ns:    extensions.TrestleNameSpace,
-				Class: extensions.TestParameterClass,
+// ActivityParameters returns the test parameter values of an Activity grouped by the controls that use them.
+//
+// The values for a control selection are read from the selection test parameter properties, with the activity test
+// parameter properties used when the selection has none. Selections with the same values are combined and groups are
+// returned in the order of the first selection in each group. When the Activity has no related controls, a single
+// group with the activity values and no controls is returned.
+func ActivityParameters(activity oscalTypes.Activity) []ControlParameters {
+	var activityParameters []extensions.Parameter
+	if activity.Props != nil {
+		activityParameters = parametersFromProps(*activity.Props)
+	}
+	if activity.RelatedControls == nil || len(activity.RelatedControls.ControlSelections) == 0 {
+		return []ControlParameters{{Parameters: activityParameters}}
+	}
+
+	var groups []ControlParameters
+	groupIndex := make(map[string]int)
+	for _, selection := range activity.RelatedControls.ControlSelections {
+		parameters := activityParameters
+		if selection.Props != nil {
+			if selectionParameters := parametersFromProps(*selection.Props); len(selectionParameters) > 0 {
+				parameters = selectionParameters
+			}
+		}
+		key := parameterKey(parameters)
+		idx, ok := groupIndex[key]
+		if !ok {
+			groupIndex[key] = len(groups)
+			groups = append(groups, ControlParameters{Parameters: parameters})
+			idx = len(groups) - 1
+		}
+		if selection.IncludeControls != nil {
+			for _, control := range *selection.IncludeControls {
+				groups[idx].ControlIds = append(groups[idx].ControlIds, control.ControlId)
 			}
-			*activity.Props = append(*activity.Props, parameterProp)
 		}
-		activities = append(activities, activity)
 	}
-	return activities, nil
+	return groups
+}
+
+// ParametersForControl returns the test parameter values of an Activity for a control. The activity values
+// are returned when the control is not in the activity related controls.
+func ParametersForControl(activity oscalTypes.Activity, controlId string) []extensions.Parameter {
+	groups := ActivityParameters(activity)
+	for _, group := range groups {
+		for _, id := range group.ControlIds {
+			if id == controlId {
+				return group.Parameters
+			}
+		}
+	}
+	if activity.Props == nil {
+		return nil
+	}
+	return parametersFromProps(*activity.Props)
+}
+
+// parametersFromProps returns the rule parameters for the test parameter properties.
+func parametersFromProps(props []oscalTypes.Property) []extensions.Parameter {
+	var parameters []extensions.Parameter
+	for _, prop := range extensions.FindAllProps(props, extensions.WithClass(extensions.TestParameterClass)) {
+		parameters = append(parameters, extensions.Parameter{
+			ID:    prop.Name,
+			Value: prop.Value,
+		})
+	}
+	return parameters
+}
+
+// parameterKey returns a string that uniquely identifies a set of parameter values
+// regardless of parameter order.
+func parameterKey(parameters []extensions.Parameter) string {
+	pairs := make([]string, 0, len(parameters))
+	for _, parameter := range parameters {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", parameter.ID, parameter.Value))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "\n")
 }
 
 // createLocationDefinitions for an AssessmentPlan from given Activities and components marked as local.
@@ -280,6 +493,10 @@ func AssessmentActivities(subject oscalTypes.AssessmentSubject, activities []osc
 
 // AssessmentAssets returns AssessmentAssets from validation components defined in the given DefinedComponents.
 func AssessmentAssets(comps []components.Component) oscalTypes.AssessmentAssets {
+	return assessmentAssets(comps, models.RandomUUID)
+}
+
+func assessmentAssets(comps []components.Component, uuidFunc models.UUIDFunc) oscalTypes.AssessmentAssets {
 	var systemComponents []oscalTypes.SystemComponent
 	var usedComponents []oscalTypes.UsesComponent
 	for _, component := range comps {
@@ -300,7 +517,7 @@ func AssessmentAssets(comps []components.Component) oscalTypes.AssessmentAssets
 
 	// AssessmentPlatforms is a required field under AssessmentAssets
 	assessmentPlatform := oscalTypes.AssessmentPlatform{
-		UUID:           uuid.NewUUID(),
+		UUID:           uuidFunc("assessment-platform"),
 		Title:          models.SampleRequiredString,
 		UsesComponents: modelutils.NilIfEmpty(&usedComponents),
 	}
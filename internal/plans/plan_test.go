@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
 	"github.com/oscal-compass/oscal-sdk-go/models"
 	"github.com/oscal-compass/oscal-sdk-go/models/components"
 	"github.com/oscal-compass/oscal-sdk-go/rules"
@@ -145,6 +146,162 @@ func TestActivitiesForComponent(t *testing.T) {
 	require.Equal(t, expectedProps, *gotActivity.Props)
 
 }
+func TestActivitiesForComponent_ControlParameters(t *testing.T) {
+	compDef := readCompDef(t)
+	testComponents := prepComponents(t, compDef)
+
+	// Tune the etcd_key_file rule for a new control
+	targetComponent := (*compDef.Components)[0]
+	implementation := (*targetComponent.ControlImplementations)[0]
+	controlSpecificReq := oscalTypes.ImplementedRequirementControlImplementation{
+		ControlId: "CIS-2.2",
+		Props: &[]oscalTypes.Property{
+			{
+				Name:  extensions.RuleIdProp,
+				Value: "etcd_key_file",
+				Ns:    extensions.TrestleNameSpace,
+			},
+		},
+		SetParameters: &[]oscalTypes.SetParameter{
+			{
+				ParamId: "file_name",
+				Values:  []string{"control_override"},
+			},
+		},
+	}
+	implementation.ImplementedRequirements = append(implementation.ImplementedRequirements, controlSpecificReq)
+	impSettings, _, err := settings.ByFramework("cis", []oscalTypes.ControlImplementationSet{implementation})
+	require.NoError(t, err)
+
+	memoryStore := rules.NewMemoryStore()
+	require.NoError(t, memoryStore.IndexAll(testComponents))
+
+	gotActivities, err := ActivitiesForComponent(context.TODO(), "TestKubernetes", memoryStore, *impSettings)
+	require.NoError(t, err)
+	require.Len(t, gotActivities, 2)
+
+	var keyFileActivity *oscalTypes.Activity
+	for i, activity := range gotActivities {
+		if activity.Title == "etcd_key_file" {
+			keyFileActivity = &gotActivities[i]
+		}
+	}
+	require.NotNil(t, keyFileActivity)
+	require.Len(t, *keyFileActivity.Steps, 1)
+
+	// Activity defaults are the values for the first control
+	paramProps := extensions.FindAllProps(*keyFileActivity.Props, extensions.WithClass(extensions.TestParameterClass))
+	require.Len(t, paramProps, 1)
+	require.Equal(t, "file_name_override", paramProps[0].Value)
+
+	gotValuesByControl := make(map[string]string)
+	require.Len(t, keyFileActivity.RelatedControls.ControlSelections, 2)
+	for _, selection := range keyFileActivity.RelatedControls.ControlSelections {
+		require.NotNil(t, selection.Props)
+		selectionProps := extensions.FindAllProps(*selection.Props, extensions.WithClass(extensions.TestParameterClass))
+		require.Len(t, selectionProps, 1)
+		controls := *selection.IncludeControls
+		require.Len(t, controls, 1)
+		gotValuesByControl[controls[0].ControlId] = selectionProps[0].Value
+	}
+
+	expectedValuesByControl := map[string]string{
+		"CIS-2.1": "file_name_override",
+		"CIS-2.2": "control_override",
+	}
+	require.Equal(t, expectedValuesByControl, gotValuesByControl)
+	for controlId, value := range expectedValuesByControl {
+		require.Equal(t, []extensions.Parameter{{ID: "file_name", Value: value}}, ParametersForControl(*keyFileActivity, controlId))
+	}
+
+	// Assessment results have one observation per check
+	plan, err := GenerateAssessmentPlan(context.TODO(), testComponents, *impSettings)
+	require.NoError(t, err)
+	require.Len(t, *plan.LocalDefinitions.Activities, 2)
+	assessmentResults, err := results.GenerateAssessmentResults(*plan)
+	require.NoError(t, err)
+	observations := *assessmentResults.Results[0].Observations
+	require.Len(t, observations, 2)
+	require.NotEqual(t, observations[0].UUID, observations[1].UUID)
+}
+
+func TestActivityParameters(t *testing.T) {
+	testParameter := func(name, value string) oscalTypes.Property {
+		return oscalTypes.Property{
+			Name:  name,
+			Value: value,
+			Ns:    extensions.TrestleNameSpace,
+			Class: extensions.TestParameterClass,
+		}
+	}
+	selection := func(props []oscalTypes.Property, controlIds ...string) oscalTypes.AssessedControls {
+		var controls []oscalTypes.AssessedControlsSelectControlById
+		for _, controlId := range controlIds {
+			controls = append(controls, oscalTypes.AssessedControlsSelectControlById{ControlId: controlId})
+		}
+		assessed := oscalTypes.AssessedControls{IncludeControls: &controls}
+		if props != nil {
+			assessed.Props = &props
+		}
+		return assessed
+	}
+
+	tests := []struct {
+		name      string
+		activity  oscalTypes.Activity
+		expGroups []ControlParameters
+	}{
+		{
+			name: "Valid/NoRelatedControls",
+			activity: oscalTypes.Activity{
+				Props: &[]oscalTypes.Property{{Name: "method", Value: "TEST"}, testParameter("param", "default")},
+			},
+			expGroups: []ControlParameters{
+				{Parameters: []extensions.Parameter{{ID: "param", Value: "default"}}},
+			},
+		},
+		{
+			name: "Valid/SelectionFallsBackToActivity",
+			activity: oscalTypes.Activity{
+				Props: &[]oscalTypes.Property{testParameter("param", "default")},
+				RelatedControls: &oscalTypes.ReviewedControls{
+					ControlSelections: []oscalTypes.AssessedControls{
+						selection(nil, "ex-1"),
+						selection([]oscalTypes.Property{testParameter("param", "override")}, "ex-2"),
+						selection([]oscalTypes.Property{testParameter("param", "default")}, "ex-3"),
+					},
+				},
+			},
+			expGroups: []ControlParameters{
+				{ControlIds: []string{"ex-1", "ex-3"}, Parameters: []extensions.Parameter{{ID: "param", Value: "default"}}},
+				{ControlIds: []string{"ex-2"}, Parameters: []extensions.Parameter{{ID: "param", Value: "override"}}},
+			},
+		},
+		{
+			name: "Valid/NoParameters",
+			activity: oscalTypes.Activity{
+				RelatedControls: &oscalTypes.ReviewedControls{
+					ControlSelections: []oscalTypes.AssessedControls{selection(nil, "ex-1", "ex-2")},
+				},
+			},
+			expGroups: []ControlParameters{
+				{ControlIds: []string{"ex-1", "ex-2"}},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.expGroups, ActivityParameters(c.activity))
+			for _, group := range c.expGroups {
+				for _, controlId := range group.ControlIds {
+					require.Equal(t, group.Parameters, ParametersForControl(c.activity, controlId))
+				}
+			}
+		})
+	}
+}
+
 func readCompDef(t *testing.T) oscalTypes.ComponentDefinition {
 	testDataPath := filepath.Join("../../testdata", "component-definition-test.json")
 
@@ -0,0 +1,351 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package poams
+
+import (
+	"fmt"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+)
+
+const (
+	// RiskStatusOpen is the status of a Risk for a check that is failing.
+	RiskStatusOpen = "open"
+	// RiskStatusClosed is the status of a Risk for a check that is passing.
+	RiskStatusClosed = "closed"
+)
+
+type generateOpts struct {
+	title     string
+	importSSP string
+	existing  *oscalTypes.PlanOfActionAndMilestones
+	uuid      models.UUIDFunc
+	clock     models.Clock
+	org       *models.Organization
+}
+
+func (g *generateOpts) defaults() {
+	g.title = models.SampleRequiredString
+	g.uuid = models.RandomUUID
+	g.clock = models.SystemClock
+}
+
+// GenerateOption defines an option to tune the behavior of the
+// GeneratePOAM function.
+type GenerateOption func(opts *generateOpts)
+
+// WithTitle is a GenerateOption that sets the PlanOfActionAndMilestones title
+// in the metadata.
+func WithTitle(title string) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.title = title
+	}
+}
+
+// WithImport is a GenerateOption that sets the SystemSecurityPlan
+// ImportSSP Href value.
+func WithImport(importSSP string) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.importSSP = importSSP
+	}
+}
+
+// WithExisting is a GenerateOption that sets an existing PlanOfActionAndMilestones
+// to update in place instead of creating a new one.
+func WithExisting(poam *oscalTypes.PlanOfActionAndMilestones) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.existing = poam
+	}
+}
+
+// WithUUIDFunc is a GenerateOption that sets the source of UUIDs for the
+// PlanOfActionAndMilestones. Use models.ContentUUID to generate the same UUIDs for the same inputs.
+func WithUUIDFunc(uuidFunc models.UUIDFunc) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.uuid = uuidFunc
+	}
+}
+
+// WithClock is a GenerateOption that sets the source of timestamps for the
+// PlanOfActionAndMilestones metadata.
+func WithClock(clock models.Clock) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.clock = clock
+	}
+}
+
+// WithOrganization is a GenerateOption that adds the organization generating the
+// PlanOfActionAndMilestones to the metadata parties with the creator role.
+func WithOrganization(org models.Organization) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.org = &org
+	}
+}
+
+// GeneratePOAM generates a PlanOfActionAndMilestones from the failing checks in AssessmentResults.
+//
+// An observation is failing when it is related to a "not-satisfied" finding or has a failing result. For each failing
+// check, identified by the assessment-check-id property, a POA&M item is created with an open Risk and the related observations.
+//
+// If `WithExisting` is set, the existing POA&M is updated. Items for checks that are failing again are updated with the new
+// observations and items for checks that now pass have the associated risks closed.
+func GeneratePOAM(assessmentResults oscalTypes.AssessmentResults, opts ...GenerateOption) (*oscalTypes.PlanOfActionAndMilestones, error) {
+	options := generateOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var poam *oscalTypes.PlanOfActionAndMilestones
+	if options.existing != nil {
+		poam = options.existing
+		poam.Metadata.LastModified = options.clock()
+	} else {
+		metadata := models.NewSampleMetadata()
+		metadata.Title = options.title
+		metadata.LastModified = options.clock()
+		poam = &oscalTypes.PlanOfActionAndMilestones{
+			UUID:      options.uuid("plan-of-action-and-milestones"),
+			Metadata:  metadata,
+			PoamItems: make([]oscalTypes.PoamItem, 0), // Required field
+		}
+	}
+	if options.org != nil {
+		models.SetOrganization(&poam.Metadata, *options.org)
+	}
+	if options.importSSP != "" {
+		poam.ImportSsp = &oscalTypes.ImportSsp{
+			Href: options.importSSP,
+		}
+	}
+
+	manager := newItemsManager(poam, options.uuid)
+	for _, result := range assessmentResults.Results {
+		if result.Observations == nil {
+			continue
+		}
+		failingFindings := make(map[string][]oscalTypes.Finding)
+		if result.Findings != nil {
+			for _, finding := range *result.Findings {
+				if finding.Target.Status.State != results.StateNotSatisfied || finding.RelatedObservations == nil {
+					continue
+				}
+				for _, related := range *finding.RelatedObservations {
+					failingFindings[related.ObservationUuid] = append(failingFindings[related.ObservationUuid], finding)
+				}
+			}
+		}
+
+		for _, observation := range *result.Observations {
+			if observation.Props == nil {
+				continue
+			}
+			check, found := extensions.GetTrestleProp(extensions.AssessmentCheckIdProp, *observation.Props)
+			if !found {
+				continue
+			}
+			findings, failedFinding := failingFindings[observation.UUID]
+			status, _ := results.ObservationResult(observation)
+			switch {
+			case failedFinding || status == extensions.ResultFail:
+				manager.open(check.Value, observation, findings)
+			case status == extensions.ResultPass:
+				manager.close(check.Value, observation)
+			}
+		}
+	}
+	manager.finalize()
+	return poam, nil
+}
+
+// itemsManager indexes and manages POA&M items, risks, observations,
+// and findings by check.
+type itemsManager struct {
+	poam         *oscalTypes.PlanOfActionAndMilestones
+	itemsByCheck map[string]int
+	risksByUUID  map[string]int
+	observations []oscalTypes.Observation
+	risks        []oscalTypes.Risk
+	findings     []oscalTypes.Finding
+	seen         set.Set[string]
+	uuid         models.UUIDFunc
+}
+
+func newItemsManager(poam *oscalTypes.PlanOfActionAndMilestones, uuidFunc models.UUIDFunc) *itemsManager {
+	m := &itemsManager{
+		poam:         poam,
+		uuid:         uuidFunc,
+		itemsByCheck: make(map[string]int),
+		risksByUUID:  make(map[string]int),
+		seen:         set.New[string](),
+	}
+	if poam.Observations != nil {
+		m.observations = *poam.Observations
+	}
+	if poam.Findings != nil {
+		m.findings = *poam.Findings
+	}
+	for _, observation := range m.observations {
+		m.seen.Add(observation.UUID)
+	}
+	for _, finding := range m.findings {
+		m.seen.Add(finding.UUID)
+	}
+	if poam.Risks != nil {
+		m.risks = *poam.Risks
+		for idx, risk := range m.risks {
+			m.risksByUUID[risk.UUID] = idx
+		}
+	}
+	for idx, item := range poam.PoamItems {
+		if item.Props == nil {
+			continue
+		}
+		check, found := extensions.GetTrestleProp(extensions.AssessmentCheckIdProp, *item.Props)
+		if found {
+			m.itemsByCheck[check.Value] = idx
+		}
+	}
+	return m
+}
+
+// open creates or updates a POA&M item and open risk for a failing check.
+func (m *itemsManager) open(checkId string, observation oscalTypes.Observation, findings []oscalTypes.Finding) {
+	m.addObservation(observation)
+	for _, finding := range findings {
+		if !m.seen.Has(finding.UUID) {
+			m.seen.Add(finding.UUID)
+			m.findings = append(m.findings, finding)
+		}
+	}
+
+	idx, ok := m.itemsByCheck[checkId]
+	if !ok {
+		risk := oscalTypes.Risk{
+			UUID:        m.uuid(fmt.Sprintf("risk/%s", checkId)),
+			Title:       fmt.Sprintf("Risk from failing check %s", checkId),
+			Description: fmt.Sprintf("The check %s failed during assessment.", checkId),
+			Statement:   models.SampleRequiredString,
+			Status:      RiskStatusOpen,
+		}
+		m.risksByUUID[risk.UUID] = len(m.risks)
+		m.risks = append(m.risks, risk)
+
+		props := []oscalTypes.Property{
+			{
+				Name:  extensions.AssessmentCheckIdProp,
+				Value: checkId,
+				Ns:    extensions.TrestleNameSpace,
+			},
+		}
+		if observation.Props != nil {
+			rule, found := extensions.GetTrestleProp(extensions.AssessmentRuleIdProp, *observation.Props)
+			if found {
+				props = append(props, rule)
+			}
+		}
+		item := oscalTypes.PoamItem{
+			UUID:         m.uuid(fmt.Sprintf("poam-item/%s", checkId)),
+			Title:        fmt.Sprintf("Remediate failing check %s", checkId),
+			Description:  fmt.Sprintf("Remediation of the findings from the failing check %s.", checkId),
+			Props:        &props,
+			RelatedRisks: &[]oscalTypes.AssociatedRisk{{RiskUuid: risk.UUID}},
+		}
+		idx = len(m.poam.PoamItems)
+		m.itemsByCheck[checkId] = idx
+		m.poam.PoamItems = append(m.poam.PoamItems, item)
+	}
+
+	item := &m.poam.PoamItems[idx]
+	item.RelatedObservations = appendObservation(item.RelatedObservations, observation.UUID)
+	for _, finding := range findings {
+		item.RelatedFindings = appendFinding(item.RelatedFindings, finding.UUID)
+	}
+	m.updateRisks(*item, RiskStatusOpen, observation.UUID)
+}
+
+// close closes the risks for an existing POA&M item for a check that now passes.
+func (m *itemsManager) close(checkId string, observation oscalTypes.Observation) {
+	idx, ok := m.itemsByCheck[checkId]
+	if !ok {
+		return
+	}
+	m.addObservation(observation)
+	item := &m.poam.PoamItems[idx]
+	item.RelatedObservations = appendObservation(item.RelatedObservations, observation.UUID)
+	m.updateRisks(*item, RiskStatusClosed, observation.UUID)
+}
+
+// updateRisks sets the status for all risks associated with an item and links the observation.
+func (m *itemsManager) updateRisks(item oscalTypes.PoamItem, status, observationUUID string) {
+	if item.RelatedRisks == nil {
+		return
+	}
+	for _, related := range *item.RelatedRisks {
+		riskIdx, ok := m.risksByUUID[related.RiskUuid]
+		if !ok {
+			continue
+		}
+		risk := &m.risks[riskIdx]
+		risk.Status = status
+		risk.RelatedObservations = appendObservation(risk.RelatedObservations, observationUUID)
+	}
+}
+
+// addObservation adds an observation to the POA&M if it is not already present.
+func (m *itemsManager) addObservation(observation oscalTypes.Observation) {
+	if m.seen.Has(observation.UUID) {
+		return
+	}
+	m.seen.Add(observation.UUID)
+	m.observations = append(m.observations, observation)
+}
+
+// finalize sets all the collected information on the POA&M.
+func (m *itemsManager) finalize() {
+	if len(m.observations) > 0 {
+		m.poam.Observations = &m.observations
+	}
+	if len(m.risks) > 0 {
+		m.poam.Risks = &m.risks
+	}
+	if len(m.findings) > 0 {
+		m.poam.Findings = &m.findings
+	}
+}
+
+// appendObservation adds a related observation to a list if not already present.
+func appendObservation(related *[]oscalTypes.RelatedObservation, observationUUID string) *[]oscalTypes.RelatedObservation {
+	if related == nil {
+		related = &[]oscalTypes.RelatedObservation{}
+	}
+	for _, existing := range *related {
+		if existing.ObservationUuid == observationUUID {
+			return related
+		}
+	}
+	*related = append(*related, oscalTypes.RelatedObservation{ObservationUuid: observationUUID})
+	return related
+}
+
+// appendFinding adds a related finding to a list if not already present.
+func appendFinding(related *[]oscalTypes.RelatedFinding, findingUUID string) *[]oscalTypes.RelatedFinding {
+	if related == nil {
+		related = &[]oscalTypes.RelatedFinding{}
+	}
+	for _, existing := range *related {
+		if existing.FindingUuid == findingUUID {
+			return related
+		}
+	}
+	*related = append(*related, oscalTypes.RelatedFinding{FindingUuid: findingUUID})
+	return related
+}
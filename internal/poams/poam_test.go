@@ -0,0 +1,181 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package poams
+
+import (
+	"testing"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+func TestGeneratePOAM(t *testing.T) {
+	firstRun := oscalTypes.AssessmentResults{
+		Results: []oscalTypes.Result{
+			{
+				Observations: &[]oscalTypes.Observation{
+					testObservation("11111111-1111-4111-8111-111111111111", "check-a", "fail"),
+					testObservation("22222222-2222-4222-8222-222222222222", "check-b", ""),
+					testObservation("33333333-3333-4333-8333-333333333333", "check-c", "pass"),
+				},
+				Findings: &[]oscalTypes.Finding{
+					{
+						UUID:        "44444444-4444-4444-8444-444444444444",
+						Title:       "ex-1",
+						Description: "ex-1",
+						Target: oscalTypes.FindingTarget{
+							TargetId: "ex-1_smt",
+							Type:     "objective-id",
+							Status: oscalTypes.ObjectiveStatus{
+								State: "not-satisfied",
+							},
+						},
+						RelatedObservations: &[]oscalTypes.RelatedObservation{
+							{ObservationUuid: "22222222-2222-4222-8222-222222222222"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	poam, err := GeneratePOAM(firstRun, WithTitle("mytitle"), WithImport("myimport"))
+	require.NoError(t, err)
+	require.Equal(t, "mytitle", poam.Metadata.Title)
+	require.Equal(t, "myimport", poam.ImportSsp.Href)
+
+	require.Len(t, poam.PoamItems, 2)
+	require.Len(t, *poam.Risks, 2)
+	require.Len(t, *poam.Observations, 2)
+	require.Len(t, *poam.Findings, 1)
+	for _, risk := range *poam.Risks {
+		require.Equal(t, RiskStatusOpen, risk.Status)
+	}
+
+	checkAItem := poam.PoamItems[0]
+	check, found := extensions.GetTrestleProp(extensions.AssessmentCheckIdProp, *checkAItem.Props)
+	require.True(t, found)
+	require.Equal(t, "check-a", check.Value)
+	rule, found := extensions.GetTrestleProp(extensions.AssessmentRuleIdProp, *checkAItem.Props)
+	require.True(t, found)
+	require.Equal(t, "rule-check-a", rule.Value)
+	require.Nil(t, checkAItem.RelatedFindings)
+
+	checkBItem := poam.PoamItems[1]
+	require.Len(t, *checkBItem.RelatedFindings, 1)
+	require.Len(t, *checkBItem.RelatedObservations, 1)
+
+	validator := validation.NewSchemaValidator()
+	require.NoError(t, validator.Validate(oscalTypes.OscalModels{PlanOfActionAndMilestones: poam}))
+
+	// The second run fixes check-a and check-b is still failing
+	secondRun := oscalTypes.AssessmentResults{
+		Results: []oscalTypes.Result{
+			{
+				Observations: &[]oscalTypes.Observation{
+					testObservation("55555555-5555-4555-8555-555555555555", "check-a", "pass"),
+					testObservation("66666666-6666-4666-8666-666666666666", "check-b", "fail"),
+				},
+			},
+		},
+	}
+	updated, err := GeneratePOAM(secondRun, WithExisting(poam))
+	require.NoError(t, err)
+	require.Same(t, poam, updated)
+	require.Len(t, updated.PoamItems, 2)
+	require.Len(t, *updated.Observations, 4)
+
+	risksByUUID := make(map[string]oscalTypes.Risk)
+	for _, risk := range *updated.Risks {
+		risksByUUID[risk.UUID] = risk
+	}
+	checkARisk := risksByUUID[(*updated.PoamItems[0].RelatedRisks)[0].RiskUuid]
+	require.Equal(t, RiskStatusClosed, checkARisk.Status)
+	require.Len(t, *checkARisk.RelatedObservations, 2)
+	checkBRisk := risksByUUID[(*updated.PoamItems[1].RelatedRisks)[0].RiskUuid]
+	require.Equal(t, RiskStatusOpen, checkBRisk.Status)
+	require.Len(t, *updated.PoamItems[1].RelatedObservations, 2)
+
+	require.NoError(t, validator.Validate(oscalTypes.OscalModels{PlanOfActionAndMilestones: updated}))
+}
+
+func TestGeneratePOAM_RelatedFindingsDeduplicated(t *testing.T) {
+	assessmentResults := oscalTypes.AssessmentResults{
+		Results: []oscalTypes.Result{
+			{
+				Observations: &[]oscalTypes.Observation{
+					testObservation("11111111-1111-4111-8111-111111111111", "check-a", "fail"),
+					testObservation("22222222-2222-4222-8222-222222222222", "check-a", "fail"),
+				},
+				Findings: &[]oscalTypes.Finding{
+					{
+						UUID:        "44444444-4444-4444-8444-444444444444",
+						Title:       "ex-1",
+						Description: "ex-1",
+						Target: oscalTypes.FindingTarget{
+							TargetId: "ex-1_smt",
+							Type:     "objective-id",
+							Status: oscalTypes.ObjectiveStatus{
+								State: "not-satisfied",
+							},
+						},
+						RelatedObservations: &[]oscalTypes.RelatedObservation{
+							{ObservationUuid: "11111111-1111-4111-8111-111111111111"},
+							{ObservationUuid: "22222222-2222-4222-8222-222222222222"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	poam, err := GeneratePOAM(assessmentResults)
+	require.NoError(t, err)
+	require.Len(t, poam.PoamItems, 1)
+	require.Len(t, *poam.Findings, 1)
+	item := poam.PoamItems[0]
+	require.Equal(t, []oscalTypes.RelatedFinding{{FindingUuid: "44444444-4444-4444-8444-444444444444"}}, *item.RelatedFindings)
+	require.Len(t, *item.RelatedObservations, 2)
+}
+
+func testObservation(uuid, checkId, result string) oscalTypes.Observation {
+	observation := oscalTypes.Observation{
+		UUID:        uuid,
+		Title:       checkId,
+		Description: models.SampleRequiredString,
+		Methods:     []string{"TEST"},
+		Collected:   time.Now(),
+		Props: &[]oscalTypes.Property{
+			{
+				Name:  extensions.AssessmentRuleIdProp,
+				Value: "rule-" + checkId,
+				Ns:    extensions.TrestleNameSpace,
+			},
+			{
+				Name:  extensions.AssessmentCheckIdProp,
+				Value: checkId,
+				Ns:    extensions.TrestleNameSpace,
+			},
+		},
+	}
+	if result != "" {
+		observation.Subjects = &[]oscalTypes.SubjectReference{
+			{
+				SubjectUuid: "77777777-7777-4777-8777-777777777777",
+				Type:        "component",
+				Props: &[]oscalTypes.Property{
+					extensions.NewResultProp(extensions.Result(result)),
+				},
+			},
+		}
+	}
+	return observation
+}
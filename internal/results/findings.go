@@ -0,0 +1,145 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package results
+
+import (
+	"fmt"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+)
+
+const (
+	// StateSatisfied is the finding target state for a control
+	// where all assessed rules pass.
+	StateSatisfied = "satisfied"
+	// StateNotSatisfied is the finding target state for a control
+	// where any assessed rule does not pass.
+	StateNotSatisfied = "not-satisfied"
+
+	statementTarget = "statement-id"
+)
+
+// SatisfiedFunc determines whether a control is satisfied based on the
+// observations for all rules mapped to the control.
+type SatisfiedFunc func(controlId string, observations []oscalTypes.Observation) bool
+
+// AllPassed is the default SatisfiedFunc. A control is satisfied when every
+// related observation has a passing result.
+//
+// Observations without a result are not passed to the SatisfiedFunc when generating
+// Findings, because the check was not run.
+func AllPassed(_ string, observations []oscalTypes.Observation) bool {
+	if len(observations) == 0 {
+		return false
+	}
+	for _, observation := range observations {
+		result, found := ObservationResult(observation)
+		if !found || result != extensions.ResultPass {
+			return false
+		}
+	}
+	return true
+}
+
+// findingsManager aggregates observations by control to
+// support Finding generation.
+type findingsManager struct {
+	controlIds            []string
+	observationsByControl map[string][]oscalTypes.Observation
+	seenByControl         map[string]set.Set[string]
+	uuid                  models.UUIDFunc
+}
+
+func newFindingsManager(uuidFunc models.UUIDFunc) *findingsManager {
+	return &findingsManager{
+		uuid:                  uuidFunc,
+		observationsByControl: make(map[string][]oscalTypes.Observation),
+		seenByControl:         make(map[string]set.Set[string]),
+	}
+}
+
+// add associates the observations for an activity to all the controls
+// in the activity related controls.
+func (f *findingsManager) add(relatedControls *oscalTypes.ReviewedControls, observations []oscalTypes.Observation) {
+	if relatedControls == nil || len(observations) == 0 {
+		return
+	}
+	for _, selection := range relatedControls.ControlSelections {
+		if selection.IncludeControls == nil {
+			continue
+		}
+		for _, control := range *selection.IncludeControls {
+			seen, ok := f.seenByControl[control.ControlId]
+			if !ok {
+				seen = set.New[string]()
+				f.seenByControl[control.ControlId] = seen
+				f.controlIds = append(f.controlIds, control.ControlId)
+			}
+			for _, observation := range observations {
+				if seen.Has(observation.UUID) {
+					continue
+				}
+				seen.Add(observation.UUID)
+				f.observationsByControl[control.ControlId] = append(f.observationsByControl[control.ControlId], observation)
+			}
+		}
+	}
+}
+
+// findings returns a Finding per control with the target status set
+// with the given SatisfiedFunc. Only observations with a result are assessed,
+// so no Finding is returned for a control where no related check was run.
+func (f *findingsManager) findings(satisfied SatisfiedFunc) []oscalTypes.Finding {
+	findings := make([]oscalTypes.Finding, 0, len(f.controlIds))
+	for _, controlId := range f.controlIds {
+		observations := assessed(f.observationsByControl[controlId])
+		if len(observations) == 0 {
+			continue
+		}
+		state := StateNotSatisfied
+		if satisfied(controlId, observations) {
+			state = StateSatisfied
+		}
+
+		relatedObservations := make([]oscalTypes.RelatedObservation, 0, len(observations))
+		for _, observation := range observations {
+			relatedObservations = append(relatedObservations, oscalTypes.RelatedObservation{
+				ObservationUuid: observation.UUID,
+			})
+		}
+
+		finding := oscalTypes.Finding{
+			UUID:        f.uuid(fmt.Sprintf("finding/%s", controlId)),
+			Title:       fmt.Sprintf("Finding For Control %q", controlId),
+			Description: fmt.Sprintf("OSCAL Assessment Finding For Control %q", controlId),
+			Target: oscalTypes.FindingTarget{
+				TargetId: fmt.Sprintf("%s_smt", controlId),
+				Type:     statementTarget,
+				Status: oscalTypes.ObjectiveStatus{
+					State: state,
+				},
+			},
+			RelatedObservations: &relatedObservations,
+		}
+		findings = append(findings, finding)
+	}
+	return findings
+}
+
+// assessed returns the observations with a result.
+func assessed(observations []oscalTypes.Observation) []oscalTypes.Observation {
+	var withResult []oscalTypes.Observation
+	for _, observation := range observations {
+		if _, found := ObservationResult(observation); found {
+			withResult = append(withResult, observation)
+		}
+	}
+	return withResult
+}
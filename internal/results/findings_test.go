@@ -0,0 +1,160 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package results
+
+import (
+	"os"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+func TestAllPassed(t *testing.T) {
+	resultProps := func(value string) *[]oscalTypes.Property {
+		return &[]oscalTypes.Property{{Name: extensions.ResultProp, Value: value}}
+	}
+
+	tests := []struct {
+		name         string
+		observations []oscalTypes.Observation
+		wantResult   bool
+	}{
+		{
+			name:       "Valid/NoObservations",
+			wantResult: false,
+		},
+		{
+			name: "Valid/ObservationPass",
+			observations: []oscalTypes.Observation{
+				{Props: resultProps("pass")},
+			},
+			wantResult: true,
+		},
+		{
+			name: "Valid/AllSubjectsPass",
+			observations: []oscalTypes.Observation{
+				{
+					Subjects: &[]oscalTypes.SubjectReference{
+						{Props: resultProps("pass")},
+						{Props: resultProps("PASS")},
+					},
+				},
+			},
+			wantResult: true,
+		},
+		{
+			name: "Valid/SubjectFails",
+			observations: []oscalTypes.Observation{
+				{
+					Subjects: &[]oscalTypes.SubjectReference{
+						{Props: resultProps("pass")},
+						{Props: resultProps("fail")},
+					},
+				},
+			},
+			wantResult: false,
+		},
+		{
+			name: "Valid/NoResult",
+			observations: []oscalTypes.Observation{
+				{Props: resultProps("pass")},
+				{},
+			},
+			wantResult: false,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.wantResult, AllPassed("ex-1", c.observations))
+		})
+	}
+}
+
+func TestGenerateAssessmentResults_Findings(t *testing.T) {
+	file, err := os.Open("../../testdata/test-ap.json")
+	require.NoError(t, err)
+	defer file.Close()
+	plan, err := models.NewAssessmentPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	observation := oscalTypes.Observation{
+		UUID:  "11111111-1111-4111-8111-111111111111",
+		Title: "check-1",
+		Props: &[]oscalTypes.Property{
+			{
+				Name:  extensions.AssessmentCheckIdProp,
+				Ns:    extensions.TrestleNameSpace,
+				Value: "check-1",
+			},
+			{
+				Name:  extensions.ResultProp,
+				Value: "pass",
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		options    []GenerateOption
+		wantStates map[string]string
+	}{
+		{
+			name: "Valid/DefaultNoResults",
+		},
+		{
+			name:    "Valid/DefaultPassing",
+			options: []GenerateOption{WithObservations([]oscalTypes.Observation{observation})},
+			wantStates: map[string]string{
+				"ex-2_smt": StateSatisfied,
+				"ex-1_smt": StateSatisfied,
+			},
+		},
+		{
+			name: "Valid/WithSatisfiedFunc",
+			options: []GenerateOption{
+				WithObservations([]oscalTypes.Observation{observation}),
+				WithSatisfiedFunc(func(controlId string, _ []oscalTypes.Observation) bool {
+					return controlId == "ex-2"
+				}),
+			},
+			wantStates: map[string]string{
+				"ex-2_smt": StateSatisfied,
+				"ex-1_smt": StateNotSatisfied,
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			assessmentResults, err := GenerateAssessmentResults(*plan, c.options...)
+			require.NoError(t, err)
+			require.Len(t, assessmentResults.Results, 1)
+
+			result := assessmentResults.Results[0]
+			require.NotNil(t, result.Observations)
+			if c.wantStates == nil {
+				// Checks without a result were not run, so the controls are not assessed
+				require.Nil(t, result.Findings)
+				return
+			}
+			require.NotNil(t, result.Findings)
+			observationUUID := (*result.Observations)[0].UUID
+
+			gotStates := make(map[string]string)
+			for _, finding := range *result.Findings {
+				gotStates[finding.Target.TargetId] = finding.Target.Status.State
+				require.Equal(t, []oscalTypes.RelatedObservation{{ObservationUuid: observationUUID}}, *finding.RelatedObservations)
+			}
+			require.Equal(t, c.wantStates, gotStates)
+		})
+	}
+}
@@ -0,0 +1,339 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package results
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/modelutils"
+)
+
+var (
+	// ErrNoResults defines an error returned when there are no Assessment Results to merge.
+	ErrNoResults = errors.New("no assessment results to merge")
+	// ErrImportMismatch defines an error returned when the Assessment Results to merge
+	// do not import the same Assessment Plan.
+	ErrImportMismatch = errors.New("assessment results do not share an import-ap")
+)
+
+// MergeAssessmentResults merges Assessment Results from several runs against the same Assessment Plan.
+//
+// Each result entry from the input is kept as a separate result entry. Observations for the same check and subjects are
+// deduplicated by keeping the Observation with a result, or the most recently collected Observation, with the origins of
+// all duplicates. Findings for the same target are reconciled into the latest result entry reporting the target with all
+// related observations, and the target is not-satisfied if any run reported it as not-satisfied. Findings in earlier result
+// entries are kept with the observation references updated. Findings where none of the related observations have a result
+// are removed, because the checks were not run. The parties, roles, and responsible parties of all
+// inputs are kept in the merged metadata. The `WithTitle` option sets the metadata title, and `WithUUIDFunc` and
+// `WithClock` set the sources of the merged document UUID and timestamp.
+func MergeAssessmentResults(assessmentResults []oscalTypes.AssessmentResults, opts ...GenerateOption) (*oscalTypes.AssessmentResults, error) {
+	options := generateOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if len(assessmentResults) == 0 {
+		return nil, ErrNoResults
+	}
+	importAP := assessmentResults[0].ImportAp
+	var entries []oscalTypes.Result
+	var localDefinitions *oscalTypes.LocalDefinitions
+	for _, ar := range assessmentResults {
+		if ar.ImportAp.Href != importAP.Href {
+			return nil, fmt.Errorf("%w: %q and %q", ErrImportMismatch, importAP.Href, ar.ImportAp.Href)
+		}
+		if localDefinitions == nil {
+			localDefinitions = ar.LocalDefinitions
+		}
+		entries = append(entries, ar.Results...)
+	}
+
+	remapped := dedupObservations(entries)
+	reconcileFindings(entries, remapped)
+
+	metadata := models.NewSampleMetadata()
+	metadata.Title = options.title
+	metadata.LastModified = options.clock()
+	for _, ar := range assessmentResults {
+		copyParties(&metadata, ar.Metadata)
+	}
+	if options.org != nil {
+		models.SetOrganization(&metadata, *options.org)
+	}
+	merged := &oscalTypes.AssessmentResults{
+		UUID:             options.uuid("assessment-results"),
+		ImportAp:         importAP,
+		Metadata:         metadata,
+		LocalDefinitions: localDefinitions,
+		Results:          entries,
+	}
+	return merged, nil
+}
+
+// dedupObservations removes duplicate observations from the result entries and returns a mapping
+// of the removed observation UUIDs to the UUID of the observation that was kept.
+func dedupObservations(entries []oscalTypes.Result) map[string]string {
+	type location struct {
+		entry int
+		index int
+	}
+	kept := make(map[string]location)
+	origins := make(map[string][]oscalTypes.Origin)
+	remapped := make(map[string]string)
+
+	for entryIdx, entry := range entries {
+		if entry.Observations == nil {
+			continue
+		}
+		for obsIdx, observation := range *entry.Observations {
+			key := observationKey(observation)
+			if observation.Origins != nil {
+				origins[key] = append(origins[key], *observation.Origins...)
+			}
+			current, ok := kept[key]
+			if !ok {
+				kept[key] = location{entry: entryIdx, index: obsIdx}
+				continue
+			}
+			existing := (*entries[current.entry].Observations)[current.index]
+			if !preferObservation(observation, existing) {
+				if observation.UUID != existing.UUID {
+					remapped[observation.UUID] = existing.UUID
+				}
+				continue
+			}
+			if observation.UUID != existing.UUID {
+				remapped[existing.UUID] = observation.UUID
+				// The kept observation is never remapped, so the chains below end.
+				delete(remapped, observation.UUID)
+			}
+			kept[key] = location{entry: entryIdx, index: obsIdx}
+		}
+	}
+	// Resolve chains of replaced observations to the final kept observation
+	for from, to := range remapped {
+		for {
+			next, ok := remapped[to]
+			if !ok {
+				break
+			}
+			to = next
+		}
+		remapped[from] = to
+	}
+
+	keptLocations := make(map[location]string)
+	for key, loc := range kept {
+		keptLocations[loc] = key
+	}
+	for entryIdx := range entries {
+		if entries[entryIdx].Observations == nil {
+			continue
+		}
+		var observations []oscalTypes.Observation
+		for obsIdx, observation := range *entries[entryIdx].Observations {
+			key, ok := keptLocations[location{entry: entryIdx, index: obsIdx}]
+			if !ok {
+				continue
+			}
+			mergedOrigins := uniqueOrigins(origins[key])
+			observation.Origins = modelutils.NilIfEmpty(&mergedOrigins)
+			observations = append(observations, observation)
+		}
+		entries[entryIdx].Observations = modelutils.NilIfEmpty(&observations)
+	}
+	return remapped
+}
+
+// preferObservation returns whether an observation should be kept over an existing duplicate. Observations
+// with a result are preferred, and the most recently collected observation is kept otherwise.
+func preferObservation(observation, existing oscalTypes.Observation) bool {
+	_, hasResult := ObservationResult(observation)
+	_, existingHasResult := ObservationResult(existing)
+	if hasResult != existingHasResult {
+		return hasResult
+	}
+	return !observation.Collected.Before(existing.Collected)
+}
+
+// reconcileFindings reconciles findings for the same target into the latest result entry reporting the
+// target and updates all observation references. Findings in earlier result entries are kept. Findings
+// where none of the related observations have a result are removed.
+func reconcileFindings(entries []oscalTypes.Result, remapped map[string]string) {
+	type reconciled struct {
+		entry        int
+		finding      oscalTypes.Finding
+		observations []oscalTypes.RelatedObservation
+		seen         set.Set[string]
+		risks        []oscalTypes.AssociatedRisk
+		seenRisks    set.Set[string]
+		notSatisfied bool
+	}
+	byTarget := make(map[string]*reconciled)
+	assessed := set.New[string]()
+	for _, entry := range entries {
+		if entry.Observations == nil {
+			continue
+		}
+		for _, observation := range *entry.Observations {
+			if _, found := ObservationResult(observation); found {
+				assessed.Add(observation.UUID)
+			}
+		}
+	}
+	isAssessed := func(finding oscalTypes.Finding) bool {
+		if finding.RelatedObservations == nil || len(*finding.RelatedObservations) == 0 {
+			return true
+		}
+		for _, related := range *finding.RelatedObservations {
+			if assessed.Has(related.ObservationUuid) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for entryIdx, entry := range entries {
+		if entry.Risks != nil {
+			risks := make([]oscalTypes.Risk, 0, len(*entry.Risks))
+			for _, risk := range *entry.Risks {
+				risk.RelatedObservations = remapObservations(risk.RelatedObservations, remapped)
+				risks = append(risks, risk)
+			}
+			entries[entryIdx].Risks = &risks
+		}
+		if entry.Findings == nil {
+			continue
+		}
+		var findings []oscalTypes.Finding
+		for _, finding := range *entry.Findings {
+			finding.RelatedObservations = remapObservations(finding.RelatedObservations, remapped)
+			if !isAssessed(finding) {
+				continue
+			}
+			findings = append(findings, finding)
+			key := fmt.Sprintf("%s/%s", finding.Target.Type, finding.Target.TargetId)
+			current, ok := byTarget[key]
+			if !ok {
+				current = &reconciled{seen: set.New[string](), seenRisks: set.New[string]()}
+				byTarget[key] = current
+			}
+			current.entry = entryIdx
+			current.finding = finding
+			if finding.Target.Status.State == StateNotSatisfied {
+				current.notSatisfied = true
+			}
+			if finding.RelatedObservations != nil {
+				for _, observation := range *finding.RelatedObservations {
+					if !current.seen.Has(observation.ObservationUuid) {
+						current.seen.Add(observation.ObservationUuid)
+						current.observations = append(current.observations, observation)
+					}
+				}
+			}
+			if finding.RelatedRisks != nil {
+				for _, risk := range *finding.RelatedRisks {
+					if !current.seenRisks.Has(risk.RiskUuid) {
+						current.seenRisks.Add(risk.RiskUuid)
+						current.risks = append(current.risks, risk)
+					}
+				}
+			}
+		}
+		entries[entryIdx].Findings = modelutils.NilIfEmpty(&findings)
+	}
+
+	for entryIdx := range entries {
+		if entries[entryIdx].Findings == nil {
+			continue
+		}
+		emitted := set.New[string]()
+		var findings []oscalTypes.Finding
+		for _, finding := range *entries[entryIdx].Findings {
+			key := fmt.Sprintf("%s/%s", finding.Target.Type, finding.Target.TargetId)
+			current := byTarget[key]
+			if current.entry != entryIdx {
+				findings = append(findings, finding)
+				continue
+			}
+			if emitted.Has(key) {
+				continue
+			}
+			emitted.Add(key)
+			mergedFinding := current.finding
+			if current.notSatisfied {
+				mergedFinding.Target.Status.State = StateNotSatisfied
+			}
+			mergedFinding.RelatedObservations = modelutils.NilIfEmpty(&current.observations)
+			mergedFinding.RelatedRisks = modelutils.NilIfEmpty(&current.risks)
+			findings = append(findings, mergedFinding)
+		}
+		entries[entryIdx].Findings = modelutils.NilIfEmpty(&findings)
+	}
+}
+
+// observationKey returns a key identifying an observation by the check
+// and the observation subjects.
+func observationKey(observation oscalTypes.Observation) string {
+	checkId := observationCheckId(observation)
+	var subjects []string
+	if observation.Subjects != nil {
+		for _, subject := range *observation.Subjects {
+			subjects = append(subjects, subject.SubjectUuid)
+		}
+	}
+	sort.Strings(subjects)
+	return fmt.Sprintf("%s|%s", checkId, strings.Join(subjects, ","))
+}
+
+// remapObservations returns the related observations with removed observations
+// replaced by the kept observation, without duplicates.
+func remapObservations(related *[]oscalTypes.RelatedObservation, remapped map[string]string) *[]oscalTypes.RelatedObservation {
+	if related == nil {
+		return nil
+	}
+	seen := set.New[string]()
+	var updated []oscalTypes.RelatedObservation
+	for _, observation := range *related {
+		if to, ok := remapped[observation.ObservationUuid]; ok {
+			observation.ObservationUuid = to
+		}
+		if seen.Has(observation.ObservationUuid) {
+			continue
+		}
+		seen.Add(observation.ObservationUuid)
+		updated = append(updated, observation)
+	}
+	return modelutils.NilIfEmpty(&updated)
+}
+
+// uniqueOrigins returns the origins without duplicates.
+func uniqueOrigins(origins []oscalTypes.Origin) []oscalTypes.Origin {
+	seen := set.New[string]()
+	var unique []oscalTypes.Origin
+	for _, origin := range origins {
+		encoded, err := json.Marshal(origin)
+		if err != nil {
+			unique = append(unique, origin)
+			continue
+		}
+		if seen.Has(string(encoded)) {
+			continue
+		}
+		seen.Add(string(encoded))
+		unique = append(unique, origin)
+	}
+	return unique
+}
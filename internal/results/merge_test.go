@@ -0,0 +1,229 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package results
+
+import (
+	"testing"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+)
+
+func TestMergeAssessmentResults(t *testing.T) {
+	observation := func(uuid, checkId, actor string, collected time.Time, result extensions.Result) oscalTypes.Observation {
+		observation := oscalTypes.Observation{
+			UUID:      uuid,
+			Title:     checkId,
+			Collected: collected,
+			Props: &[]oscalTypes.Property{
+				{
+					Name:  extensions.AssessmentCheckIdProp,
+					Value: checkId,
+					Ns:    extensions.TrestleNameSpace,
+				},
+			},
+			Subjects: &[]oscalTypes.SubjectReference{{SubjectUuid: "subject-1", Type: "component"}},
+			Origins: &[]oscalTypes.Origin{
+				{Actors: []oscalTypes.OriginActor{{ActorUuid: actor, Type: defaultActor}}},
+			},
+		}
+		if result != "" {
+			SetObservationResult(&observation, result, "")
+		}
+		return observation
+	}
+	finding := func(target, state string, observations ...string) oscalTypes.Finding {
+		var related []oscalTypes.RelatedObservation
+		for _, uuid := range observations {
+			related = append(related, oscalTypes.RelatedObservation{ObservationUuid: uuid})
+		}
+		return oscalTypes.Finding{
+			UUID: "finding-" + state,
+			Target: oscalTypes.FindingTarget{
+				TargetId: target,
+				Type:     statementTarget,
+				Status:   oscalTypes.ObjectiveStatus{State: state},
+			},
+			RelatedObservations: &related,
+		}
+	}
+
+	earlier := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+	clusterRun := oscalTypes.AssessmentResults{
+		ImportAp: oscalTypes.ImportAp{Href: "ap.json"},
+		Results: []oscalTypes.Result{
+			{
+				UUID:         "cluster",
+				Observations: &[]oscalTypes.Observation{observation("obs-a", "check-1", "cluster-validator", earlier, extensions.ResultFail)},
+				Findings:     &[]oscalTypes.Finding{finding("ex-1_smt", StateNotSatisfied, "obs-a")},
+			},
+		},
+	}
+	ciRun := oscalTypes.AssessmentResults{
+		ImportAp: oscalTypes.ImportAp{Href: "ap.json"},
+		Results: []oscalTypes.Result{
+			{
+				UUID: "ci",
+				Observations: &[]oscalTypes.Observation{
+					observation("obs-b", "check-1", "ci-validator", later, extensions.ResultPass),
+					observation("obs-c", "check-2", "ci-validator", later, extensions.ResultPass),
+				},
+				Findings: &[]oscalTypes.Finding{finding("ex-1_smt", StateSatisfied, "obs-b", "obs-c")},
+			},
+		},
+	}
+
+	merged, err := MergeAssessmentResults([]oscalTypes.AssessmentResults{clusterRun, ciRun}, WithTitle("merged"))
+	require.NoError(t, err)
+	require.Equal(t, "merged", merged.Metadata.Title)
+	require.Equal(t, "ap.json", merged.ImportAp.Href)
+	require.Len(t, merged.Results, 2)
+
+	// The earlier observation is replaced by the ci run and the
+	// earlier finding is kept with the updated reference
+	require.Nil(t, merged.Results[0].Observations)
+	require.Len(t, *merged.Results[0].Findings, 1)
+	require.Equal(t, []oscalTypes.RelatedObservation{{ObservationUuid: "obs-b"}}, *(*merged.Results[0].Findings)[0].RelatedObservations)
+
+	observations := *merged.Results[1].Observations
+	require.Len(t, observations, 2)
+	require.Equal(t, "obs-b", observations[0].UUID)
+	require.Len(t, *observations[0].Origins, 2)
+	require.Equal(t, "obs-c", observations[1].UUID)
+
+	findings := *merged.Results[1].Findings
+	require.Len(t, findings, 1)
+	require.Equal(t, StateNotSatisfied, findings[0].Target.Status.State)
+	expectedRelated := []oscalTypes.RelatedObservation{{ObservationUuid: "obs-b"}, {ObservationUuid: "obs-c"}}
+	require.Equal(t, expectedRelated, *findings[0].RelatedObservations)
+
+	// Inputs are not modified
+	require.Len(t, *clusterRun.Results[0].Observations, 1)
+	require.Equal(t, StateSatisfied, (*ciRun.Results[0].Findings)[0].Target.Status.State)
+}
+
+func TestMergeAssessmentResults_NoResults(t *testing.T) {
+	observation := func(uuid, checkId string, collected time.Time, result extensions.Result) oscalTypes.Observation {
+		observation := oscalTypes.Observation{
+			UUID:      uuid,
+			Title:     checkId,
+			Collected: collected,
+			Props: &[]oscalTypes.Property{
+				{
+					Name:  extensions.AssessmentCheckIdProp,
+					Value: checkId,
+					Ns:    extensions.TrestleNameSpace,
+				},
+			},
+		}
+		if result != "" {
+			SetObservationResult(&observation, result, "")
+		}
+		return observation
+	}
+	finding := func(uuid, target, observationUUID string) oscalTypes.Finding {
+		return oscalTypes.Finding{
+			UUID: uuid,
+			Target: oscalTypes.FindingTarget{
+				TargetId: target,
+				Type:     statementTarget,
+				Status:   oscalTypes.ObjectiveStatus{State: StateNotSatisfied},
+			},
+			RelatedObservations: &[]oscalTypes.RelatedObservation{{ObservationUuid: observationUUID}},
+		}
+	}
+
+	earlier := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+	firstRun := oscalTypes.AssessmentResults{
+		ImportAp: oscalTypes.ImportAp{Href: "ap.json"},
+		Results: []oscalTypes.Result{
+			{
+				UUID:         "first",
+				Observations: &[]oscalTypes.Observation{observation("obs-a", "check-1", earlier, extensions.ResultFail)},
+				Findings:     &[]oscalTypes.Finding{finding("finding-a", "ex-1_smt", "obs-a")},
+			},
+		},
+	}
+	// The second run did not run any checks
+	secondRun := oscalTypes.AssessmentResults{
+		ImportAp: oscalTypes.ImportAp{Href: "ap.json"},
+		Results: []oscalTypes.Result{
+			{
+				UUID: "second",
+				Observations: &[]oscalTypes.Observation{
+					observation("obs-b", "check-1", later, ""),
+					observation("obs-c", "check-2", later, ""),
+				},
+				Findings: &[]oscalTypes.Finding{finding("finding-c", "ex-2_smt", "obs-c")},
+			},
+		},
+	}
+
+	merged, err := MergeAssessmentResults([]oscalTypes.AssessmentResults{firstRun, secondRun})
+	require.NoError(t, err)
+	require.Len(t, merged.Results, 2)
+
+	// The observation with a result is kept over the later observation without one
+	require.Equal(t, []oscalTypes.Observation{(*firstRun.Results[0].Observations)[0]}, *merged.Results[0].Observations)
+	require.Len(t, *merged.Results[1].Observations, 1)
+	require.Equal(t, "obs-c", (*merged.Results[1].Observations)[0].UUID)
+
+	// The finding for checks that were not run is removed
+	require.Len(t, *merged.Results[0].Findings, 1)
+	require.Equal(t, "finding-a", (*merged.Results[0].Findings)[0].UUID)
+	require.Nil(t, merged.Results[1].Findings)
+}
+
+func TestMergeAssessmentResults_SameObservationUUIDs(t *testing.T) {
+	run := func(resultUUID, observationUUID string, collected time.Time) oscalTypes.AssessmentResults {
+		observation := oscalTypes.Observation{
+			UUID:      observationUUID,
+			Title:     "check-1",
+			Collected: collected,
+		}
+		SetObservationResult(&observation, extensions.ResultPass, "")
+		return oscalTypes.AssessmentResults{
+			ImportAp: oscalTypes.ImportAp{Href: "ap.json"},
+			Results: []oscalTypes.Result{
+				{
+					UUID:         resultUUID,
+					Observations: &[]oscalTypes.Observation{observation},
+				},
+			},
+		}
+	}
+
+	// Runs generated with reproducible UUIDs report the same observation UUIDs
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	merged, err := MergeAssessmentResults([]oscalTypes.AssessmentResults{
+		run("first", "obs-a", start),
+		run("second", "obs-b", start.Add(time.Hour)),
+		run("third", "obs-a", start.Add(2*time.Hour)),
+	})
+	require.NoError(t, err)
+	require.Len(t, merged.Results, 3)
+	require.Nil(t, merged.Results[0].Observations)
+	require.Nil(t, merged.Results[1].Observations)
+	require.Len(t, *merged.Results[2].Observations, 1)
+	require.Equal(t, "obs-a", (*merged.Results[2].Observations)[0].UUID)
+}
+
+func TestMergeAssessmentResults_Errors(t *testing.T) {
+	_, err := MergeAssessmentResults(nil)
+	require.ErrorIs(t, err, ErrNoResults)
+
+	_, err = MergeAssessmentResults([]oscalTypes.AssessmentResults{
+		{ImportAp: oscalTypes.ImportAp{Href: "ap.json"}},
+		{ImportAp: oscalTypes.ImportAp{Href: "other-ap.json"}},
+	})
+	require.ErrorIs(t, err, ErrImportMismatch)
+	require.EqualError(t, err, "assessment results do not share an import-ap: \"ap.json\" and \"other-ap.json\"")
+}
@@ -6,28 +6,36 @@
 package results
 
 import (
-	"time"
+	"fmt"
 
-	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 
 	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models"
 )
 
 // observationsManager indexes and manages OSCAL Observations
 // to support Assessment Result generation.
 type observationsManager struct {
-	observationsByCheck map[string]oscalTypes.Observation
+	observationsByCheck map[string][]oscalTypes.Observation
+	loaded              set.Set[string]
 	actorsByCheck       map[string]string
+	uuid                models.UUIDFunc
+	clock               models.Clock
 }
 
 // newObservationManager creates an observationManager struct loaded with
-// actor information from the Assessment Plan Assessment Assets.
-func newObservationManager(plan oscalTypes.AssessmentPlan) *observationsManager {
+// actor information from the Assessment Plan Assessment Assets. New observations
+// use the given UUIDFunc and Clock.
+func newObservationManager(plan oscalTypes.AssessmentPlan, uuidFunc models.UUIDFunc, clock models.Clock) *observationsManager {
 	// Index validation components to set the Actor information
 	m := &observationsManager{
-		observationsByCheck: make(map[string]oscalTypes.Observation),
+		observationsByCheck: make(map[string][]oscalTypes.Observation),
+		loaded:              set.New[string](),
 		actorsByCheck:       make(map[string]string),
+		uuid:                uuidFunc,
+		clock:               clock,
 	}
 	if plan.AssessmentAssets != nil && plan.AssessmentAssets.Components != nil {
 		for _, comp := range *plan.AssessmentAssets.Components {
@@ -43,31 +51,26 @@ func newObservationManager(plan oscalTypes.AssessmentPlan) *observationsManager
 	return m
 }
 
-// load indexing and updates a set of given observations.
-func (o *observationsManager) load(observations []oscalTypes.Observation) {
+// load indexes and updates a set of given observations by check id and subjects. Observations for the
+// same check with different subjects are kept, and an error is returned for observations with the same
+// check and subjects.
+func (o *observationsManager) load(observations []oscalTypes.Observation) error {
 	for _, observation := range observations {
-		o.updateObservation(&observation)
+		checkId := observationCheckId(observation)
+		key := observationKey(observation)
+		if o.loaded.Has(key) {
+			return fmt.Errorf("duplicate observations for check %s with the same subjects", checkId)
+		}
+		o.loaded.Add(key)
+		o.updateObservation(checkId, &observation)
 	}
+	return nil
 }
 
-// createOrGet return an existing observation or a newly created one.
-func (o *observationsManager) createOrGet(checkId, ruleId string) oscalTypes.Observation {
-	for _, observation := range o.observationsByCheck {
-		// Loop through the Props slice to find the AssessmentCheckIdProp
-		if observation.Props == nil {
-			continue
-		}
-		check, found := extensions.GetTrestleProp(extensions.AssessmentCheckIdProp, *observation.Props)
-		if !found || check.Value != checkId {
-			continue
-		} else {
-			return observation
-		}
-	}
-	// Using observation title as a fallback
-	observation, ok := o.observationsByCheck[checkId]
-	if ok {
-		return observation
+// createOrGet return the existing observations for a check or a newly created one.
+func (o *observationsManager) createOrGet(checkId, ruleId string) []oscalTypes.Observation {
+	if observations, ok := o.observationsByCheck[checkId]; ok {
+		return observations
 	}
 
 	props := []oscalTypes.Property{
@@ -84,17 +87,17 @@ func (o *observationsManager) createOrGet(checkId, ruleId string) oscalTypes.Obs
 	}
 
 	emptyObservation := oscalTypes.Observation{
-		UUID:      uuid.NewUUID(),
+		UUID:      o.uuid(fmt.Sprintf("observation/%s/%s", ruleId, checkId)),
 		Title:     checkId,
-		Collected: time.Now(),
+		Collected: o.clock(),
 		Props:     &props,
 	}
-	o.updateObservation(&emptyObservation)
-	return emptyObservation
+	o.updateObservation(checkId, &emptyObservation)
+	return o.observationsByCheck[checkId]
 }
 
 // updateObservation with Origin Actor information
-func (o *observationsManager) updateObservation(observation *oscalTypes.Observation) {
+func (o *observationsManager) updateObservation(checkId string, observation *oscalTypes.Observation) {
 	actor, found := o.actorsByCheck[observation.Title]
 	if found {
 		origins := []oscalTypes.Origin{
@@ -109,5 +112,16 @@ func (o *observationsManager) updateObservation(observation *oscalTypes.Observat
 		}
 		observation.Origins = &origins
 	}
-	o.observationsByCheck[observation.Title] = *observation
+	o.observationsByCheck[checkId] = append(o.observationsByCheck[checkId], *observation)
+}
+
+// observationCheckId returns the check id of an Observation from the assessment-check-id property,
+// using the Observation title as a fallback.
+func observationCheckId(observation oscalTypes.Observation) string {
+	if observation.Props != nil {
+		if check, found := extensions.GetTrestleProp(extensions.AssessmentCheckIdProp, *observation.Props); found {
+			return check.Value
+		}
+	}
+	return observation.Title
 }
@@ -7,9 +7,8 @@ package results
 
 import (
 	"fmt"
-	"time"
+	"slices"
 
-	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 
 	"github.com/oscal-compass/oscal-sdk-go/extensions"
@@ -22,11 +21,18 @@ type generateOpts struct {
 	title        string
 	importAP     string
 	observations []oscalTypes.Observation
+	satisfied    SatisfiedFunc
+	uuid         models.UUIDFunc
+	clock        models.Clock
+	org          *models.Organization
 }
 
 func (g *generateOpts) defaults() {
 	g.title = models.SampleRequiredString
 	g.importAP = models.SampleRequiredString
+	g.satisfied = AllPassed
+	g.uuid = models.RandomUUID
+	g.clock = models.SystemClock
 }
 
 // GenerateOption defines an option to tune the behavior of the
@@ -57,12 +63,53 @@ func WithObservations(observations []oscalTypes.Observation) GenerateOption {
 	}
 }
 
+// WithSatisfiedFunc is a GenerateOption that sets the logic used to determine
+// whether a control is satisfied in the generated Findings.
+func WithSatisfiedFunc(satisfied SatisfiedFunc) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.satisfied = satisfied
+	}
+}
+
+// WithUUIDFunc is a GenerateOption that sets the source of UUIDs for the
+// AssessmentResults. Use models.ContentUUID to generate the same UUIDs for the same inputs.
+func WithUUIDFunc(uuidFunc models.UUIDFunc) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.uuid = uuidFunc
+	}
+}
+
+// WithClock is a GenerateOption that sets the source of timestamps for the
+// AssessmentResults metadata, result entries, and generated Observations.
+func WithClock(clock models.Clock) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.clock = clock
+	}
+}
+
+// WithOrganization is a GenerateOption that adds the organization generating the
+// AssessmentResults to the metadata parties with the creator role.
+func WithOrganization(org models.Organization) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.org = &org
+	}
+}
+
 // GenerateAssessmentResults generates an AssessmentPlan for a set of Components and ImplementationSettings. The chosen inputs allow an Assessment Plan to be generated from
 // a set of OSCAL ComponentDefinitions or a SystemSecurityPlan.
 //
 // If `WithImport` is not set, all input components are set as Components in the Local Definitions.
 // If `WithObservations is not set, default behavior is to create a new, empty Observation for each activity step with the step.Title as the
-// Observation title.
+// Observation title. Observations given with `WithObservations` are matched to steps by the assessment-check-id property or title,
+// and all the Observations for a check are included. An error is returned when Observations for the same check have the same subjects.
+//
+// A Finding is created for each reviewed control with observations that have a result. The observations with a result for
+// all rules mapped to a control through the activity related controls are linked to the Finding and the target status is set
+// using the SatisfiedFunc. Controls where no related check has a result are not assessed and have no Finding.
+// If `WithSatisfiedFunc` is not set, a control is satisfied only when all the related observations pass.
+//
+// The parties, roles, and responsible parties in the AssessmentPlan metadata are copied to the AssessmentResults
+// metadata, so an organization set on the plan is kept in the results.
 func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateOption) (*oscalTypes.AssessmentResults, error) {
 	options := generateOpts{}
 	options.defaults()
@@ -72,9 +119,14 @@ func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateO
 
 	metadata := models.NewSampleMetadata()
 	metadata.Title = options.title
+	metadata.LastModified = options.clock()
+	copyParties(&metadata, plan.Metadata)
+	if options.org != nil {
+		models.SetOrganization(&metadata, *options.org)
+	}
 
 	assessmentResults := &oscalTypes.AssessmentResults{
-		UUID: uuid.NewUUID(),
+		UUID: options.uuid("assessment-results"),
 		ImportAp: oscalTypes.ImportAp{
 			Href: options.importAP,
 		},
@@ -87,9 +139,11 @@ func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateO
 	}
 	tasks := *plan.Tasks
 
-	observationManager := newObservationManager(plan)
+	observationManager := newObservationManager(plan, options.uuid, options.clock)
 	if options.observations != nil {
-		observationManager.load(options.observations)
+		if err := observationManager.load(options.observations); err != nil {
+			return nil, err
+		}
 	}
 
 	activitiesByUUID := make(map[string]oscalTypes.Activity)
@@ -104,8 +158,8 @@ func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateO
 		result := oscalTypes.Result{
 			Title:       fmt.Sprintf("Result For Task %q", task.Title),
 			Description: fmt.Sprintf("OSCAL Assessment Result For Task %q", task.Title),
-			Start:       time.Now(),
-			UUID:        uuid.NewUUID(),
+			Start:       options.clock(),
+			UUID:        options.uuid(fmt.Sprintf("result/%s", task.UUID)),
 		}
 
 		// Some initial checks before proceeding with the rest
@@ -118,6 +172,7 @@ func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateO
 		// checks.
 		var reviewedControls oscalTypes.ReviewedControls
 		var associatedObservations []oscalTypes.Observation
+		findingsManager := newFindingsManager(options.uuid)
 		for _, assocActivity := range *task.AssociatedActivities {
 			activity := activitiesByUUID[assocActivity.ActivityUuid]
 
@@ -126,6 +181,7 @@ func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateO
 			}
 
 			if activity.Steps != nil {
+				var activityObservations []oscalTypes.Observation
 				relatedTask := oscalTypes.RelatedTask{
 					TaskUuid: task.UUID,
 					Subjects: &assocActivity.Subjects,
@@ -140,34 +196,39 @@ func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateO
 					setWaivedProp = true
 				}
 				// Activity Title == Rule
-				// One Observation per Activity Step
+				// One Observation per Activity Step and subjects
 				// Observation Title == Check
 				for _, step := range *activity.Steps {
-					observation := observationManager.createOrGet(step.Title, activity.Title)
-					for _, method := range methods {
-						observation.Methods = append(observation.Methods, method.Value)
-					}
-					// Add a waived property to each observation subject if the activity is waived
-					if setWaivedProp {
-						for _, subject := range *observation.Subjects {
-							property := oscalTypes.Property{
-								Name:  extensions.WaivedRulesProperty,
-								Value: "true",
-								Ns:    extensions.TrestleNameSpace,
+					for _, observation := range observationManager.createOrGet(step.Title, activity.Title) {
+						for _, method := range methods {
+							if !slices.Contains(observation.Methods, method.Value) {
+								observation.Methods = append(observation.Methods, method.Value)
+							}
+						}
+						// Add a waived property to each observation subject if the activity is waived
+						if setWaivedProp {
+							for _, subject := range *observation.Subjects {
+								property := oscalTypes.Property{
+									Name:  extensions.WaivedRulesProperty,
+									Value: "true",
+									Ns:    extensions.TrestleNameSpace,
+								}
+								*subject.Props = append(*subject.Props, property)
 							}
-							*subject.Props = append(*subject.Props, property)
 						}
-					}
 
-					if observation.Origins != nil && len(*observation.Origins) == 1 {
-						origin := *observation.Origins
-						if origin[0].RelatedTasks == nil {
-							origin[0].RelatedTasks = &[]oscalTypes.RelatedTask{}
+						if observation.Origins != nil && len(*observation.Origins) == 1 {
+							origin := *observation.Origins
+							if origin[0].RelatedTasks == nil {
+								origin[0].RelatedTasks = &[]oscalTypes.RelatedTask{}
+							}
+							*origin[0].RelatedTasks = append(*origin[0].RelatedTasks, relatedTask)
 						}
-						*origin[0].RelatedTasks = append(*origin[0].RelatedTasks, relatedTask)
+						activityObservations = append(activityObservations, observation)
 					}
-					associatedObservations = append(associatedObservations, observation)
 				}
+				findingsManager.add(activity.RelatedControls, activityObservations)
+				associatedObservations = append(associatedObservations, activityObservations...)
 			}
 		}
 
@@ -175,8 +236,32 @@ func GenerateAssessmentResults(plan oscalTypes.AssessmentPlan, opts ...GenerateO
 		if len(associatedObservations) > 0 {
 			result.Observations = &associatedObservations
 		}
+		findings := findingsManager.findings(options.satisfied)
+		if len(findings) > 0 {
+			result.Findings = &findings
+		}
 		assessmentResults.Results = append(assessmentResults.Results, result)
 	}
 
 	return assessmentResults, nil
 }
+
+// copyParties copies the parties, roles, and responsible parties from
+// one Metadata to another.
+func copyParties(metadata *oscalTypes.Metadata, from oscalTypes.Metadata) {
+	if from.Roles != nil {
+		for _, role := range *from.Roles {
+			models.SetRole(metadata, role)
+		}
+	}
+	if from.Parties != nil {
+		for _, party := range *from.Parties {
+			models.SetParty(metadata, party)
+		}
+	}
+	if from.ResponsibleParties != nil {
+		for _, responsibleParty := range *from.ResponsibleParties {
+			models.AddResponsibleParty(metadata, responsibleParty.RoleId, responsibleParty.PartyUuids...)
+		}
+	}
+}
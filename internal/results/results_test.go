@@ -6,8 +6,10 @@
 package results
 
 import (
+	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 	"github.com/stretchr/testify/require"
@@ -382,6 +384,38 @@ func TestGenerateAssessmentResults(t *testing.T) {
 				require.True(t, checkIdFound)
 			},
 		},
+		{
+			name: "Success/WithObservationsForDifferentSubjects",
+			inputOptions: []GenerateOption{
+				WithObservations([]oscalTypes.Observation{
+					subjectObservation("11111111-1111-4111-8111-111111111111", "22222222-2222-4222-8222-222222222222"),
+					subjectObservation("33333333-3333-4333-8333-333333333333", "44444444-4444-4444-8444-444444444444"),
+				}),
+			},
+			assessmentPlan: defaultAssessmentPlan,
+			assertFunc: func(t *testing.T, results *oscalTypes.AssessmentResults) {
+				require.Len(t, results.Results, 1)
+				require.NotNil(t, results.Results[0].Observations)
+				var gotUUIDs []string
+				for _, observation := range *results.Results[0].Observations {
+					if observation.Title == "check-1" {
+						gotUUIDs = append(gotUUIDs, observation.UUID)
+					}
+				}
+				require.Equal(t, []string{"11111111-1111-4111-8111-111111111111", "33333333-3333-4333-8333-333333333333"}, gotUUIDs)
+			},
+		},
+		{
+			name: "Failure/DuplicateObservations",
+			inputOptions: []GenerateOption{
+				WithObservations([]oscalTypes.Observation{
+					subjectObservation("11111111-1111-4111-8111-111111111111", "22222222-2222-4222-8222-222222222222"),
+					subjectObservation("33333333-3333-4333-8333-333333333333", "22222222-2222-4222-8222-222222222222"),
+				}),
+			},
+			assessmentPlan: defaultAssessmentPlan,
+			expError:       "duplicate observations for check check-1 with the same subjects",
+		},
 		{
 			name:           "Failure/NoTasksPlan",
 			assessmentPlan: &oscalTypes.AssessmentPlan{},
@@ -401,3 +435,44 @@ func TestGenerateAssessmentResults(t *testing.T) {
 		})
 	}
 }
+
+// subjectObservation returns an Observation for check-1 with a single subject.
+func subjectObservation(observationUUID, subjectUUID string) oscalTypes.Observation {
+	return oscalTypes.Observation{
+		UUID:  observationUUID,
+		Title: "check-1",
+		Subjects: &[]oscalTypes.SubjectReference{
+			{SubjectUuid: subjectUUID, Type: "component", Props: &[]oscalTypes.Property{}},
+		},
+	}
+}
+
+func TestGenerateAssessmentResults_Reproducible(t *testing.T) {
+	file, err := os.Open("../../testdata/test-ap.json")
+	require.NoError(t, err)
+	defer file.Close()
+	plan, err := models.NewAssessmentPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	generate := func() []byte {
+		assessmentResults, err := GenerateAssessmentResults(*plan, WithUUIDFunc(models.ContentUUID("test")), WithClock(models.FixedClock(now)))
+		require.NoError(t, err)
+		data, err := json.Marshal(assessmentResults)
+		require.NoError(t, err)
+		return data
+	}
+	first := generate()
+	require.Equal(t, string(first), string(generate()))
+
+	var assessmentResults oscalTypes.AssessmentResults
+	require.NoError(t, json.Unmarshal(first, &assessmentResults))
+	require.True(t, now.Equal(assessmentResults.Metadata.LastModified))
+	require.Len(t, assessmentResults.Results, 1)
+	result := assessmentResults.Results[0]
+	require.True(t, now.Equal(result.Start))
+	require.NotNil(t, result.Observations)
+	for _, observation := range *result.Observations {
+		require.True(t, now.Equal(observation.Collected))
+	}
+}
@@ -0,0 +1,173 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package results
+
+import (
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+)
+
+// resultPrecedence defines the order used to aggregate subject results, with
+// higher values taking precedence.
+var resultPrecedence = map[extensions.Result]int{
+	extensions.ResultNotApplicable: 0,
+	extensions.ResultPass:          1,
+	extensions.ResultError:         2,
+	extensions.ResultFail:          3,
+}
+
+// SetObservationResult sets the result and optional reason on an Observation,
+// replacing any existing values.
+func SetObservationResult(observation *oscalTypes.Observation, result extensions.Result, reason string) {
+	observation.Props = setResultProps(observation.Props, result, reason)
+}
+
+// SetSubjectResult sets the result and optional reason on an Observation subject,
+// replacing any existing values.
+func SetSubjectResult(subject *oscalTypes.SubjectReference, result extensions.Result, reason string) {
+	subject.Props = setResultProps(subject.Props, result, reason)
+}
+
+// AddObservationEvidence adds a link to relevant evidence supporting the Observation result.
+func AddObservationEvidence(observation *oscalTypes.Observation, href, description string) {
+	if observation.RelevantEvidence == nil {
+		observation.RelevantEvidence = &[]oscalTypes.RelevantEvidence{}
+	}
+	*observation.RelevantEvidence = append(*observation.RelevantEvidence, oscalTypes.RelevantEvidence{
+		Href:        href,
+		Description: description,
+	})
+}
+
+// AddSubjectEvidence adds an evidence link supporting the Observation subject result.
+func AddSubjectEvidence(subject *oscalTypes.SubjectReference, href string) {
+	if subject.Links == nil {
+		subject.Links = &[]oscalTypes.Link{}
+	}
+	*subject.Links = append(*subject.Links, oscalTypes.Link{
+		Href: href,
+		Rel:  extensions.EvidenceRel,
+	})
+}
+
+// ObservationResult returns the result for an Observation. A result set on the Observation is returned as is,
+// otherwise the subject results are aggregated with a failure taking precedence over an error, and an error over a pass.
+// The result is only not-applicable when all subjects with a result are not-applicable.
+func ObservationResult(observation oscalTypes.Observation) (extensions.Result, bool) {
+	if observation.Props != nil {
+		if result, found := extensions.GetResult(*observation.Props); found {
+			return result, true
+		}
+	}
+	if observation.Subjects == nil {
+		return "", false
+	}
+	var subjectResults []extensions.Result
+	for _, subject := range *observation.Subjects {
+		result, ok := SubjectResult(subject)
+		if ok {
+			subjectResults = append(subjectResults, result)
+		}
+	}
+	if len(subjectResults) == 0 {
+		return "", false
+	}
+	return AggregateResults(subjectResults...), true
+}
+
+// AggregateResults returns the combined result with a failure taking precedence over an error, and an error over a pass.
+// The result is only not-applicable when all results are not-applicable or no results are given.
+func AggregateResults(results ...extensions.Result) extensions.Result {
+	aggregated := extensions.ResultNotApplicable
+	for _, result := range results {
+		if resultPrecedence[result] > resultPrecedence[aggregated] {
+			aggregated = result
+		}
+	}
+	return aggregated
+}
+
+// SubjectResult returns the result for an Observation subject.
+func SubjectResult(subject oscalTypes.SubjectReference) (extensions.Result, bool) {
+	if subject.Props == nil {
+		return "", false
+	}
+	return extensions.GetResult(*subject.Props)
+}
+
+// ObservationReason returns the result reason for an Observation.
+func ObservationReason(observation oscalTypes.Observation) string {
+	return reason(observation.Props)
+}
+
+// SubjectReason returns the result reason for an Observation subject.
+func SubjectReason(subject oscalTypes.SubjectReference) string {
+	return reason(subject.Props)
+}
+
+// ObservationEvidence returns the links to relevant evidence for an Observation.
+func ObservationEvidence(observation oscalTypes.Observation) []string {
+	var hrefs []string
+	if observation.RelevantEvidence != nil {
+		for _, evidence := range *observation.RelevantEvidence {
+			if evidence.Href != "" {
+				hrefs = append(hrefs, evidence.Href)
+			}
+		}
+	}
+	return hrefs
+}
+
+// SubjectEvidence returns the evidence links for an Observation subject.
+func SubjectEvidence(subject oscalTypes.SubjectReference) []string {
+	var hrefs []string
+	if subject.Links != nil {
+		for _, link := range *subject.Links {
+			if link.Rel == extensions.EvidenceRel {
+				hrefs = append(hrefs, link.Href)
+			}
+		}
+	}
+	return hrefs
+}
+
+// setResultProps replaces any result and reason properties in the set
+// with the given values.
+func setResultProps(props *[]oscalTypes.Property, result extensions.Result, reason string) *[]oscalTypes.Property {
+	var updated []oscalTypes.Property
+	if props != nil {
+		for _, prop := range *props {
+			if isTrestleOrEmpty(prop) && (prop.Name == extensions.ResultProp || prop.Name == extensions.ReasonProp) {
+				continue
+			}
+			updated = append(updated, prop)
+		}
+	}
+	updated = append(updated, extensions.NewResultProp(result))
+	if reason != "" {
+		updated = append(updated, extensions.NewReasonProp(reason))
+	}
+	return &updated
+}
+
+func reason(props *[]oscalTypes.Property) string {
+	if props == nil {
+		return ""
+	}
+	for _, prop := range *props {
+		if prop.Name == extensions.ReasonProp && isTrestleOrEmpty(prop) {
+			return prop.Value
+		}
+	}
+	return ""
+}
+
+func isTrestleOrEmpty(prop oscalTypes.Property) bool {
+	return prop.Ns == "" || strings.Contains(prop.Ns, extensions.TrestleNameSpace)
+}
@@ -0,0 +1,114 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package results
+
+import (
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+)
+
+func TestSetObservationResult(t *testing.T) {
+	observation := oscalTypes.Observation{
+		Props: &[]oscalTypes.Property{
+			{
+				Name:  extensions.AssessmentCheckIdProp,
+				Value: "check-1",
+				Ns:    extensions.TrestleNameSpace,
+			},
+			{
+				Name:  extensions.ResultProp,
+				Value: "fail",
+			},
+		},
+	}
+
+	SetObservationResult(&observation, extensions.ResultPass, "all resources compliant")
+	result, found := ObservationResult(observation)
+	require.True(t, found)
+	require.Equal(t, extensions.ResultPass, result)
+	require.Equal(t, "all resources compliant", ObservationReason(observation))
+	require.Len(t, *observation.Props, 3)
+
+	AddObservationEvidence(&observation, "https://example.com/evidence.json", "evidence")
+	require.Equal(t, []string{"https://example.com/evidence.json"}, ObservationEvidence(observation))
+}
+
+func TestObservationResult(t *testing.T) {
+	subject := func(result extensions.Result) oscalTypes.SubjectReference {
+		subject := oscalTypes.SubjectReference{}
+		SetSubjectResult(&subject, result, "")
+		return subject
+	}
+
+	tests := []struct {
+		name       string
+		subjects   []oscalTypes.SubjectReference
+		wantResult extensions.Result
+		wantFound  bool
+	}{
+		{
+			name:      "Valid/NoResults",
+			subjects:  []oscalTypes.SubjectReference{{}},
+			wantFound: false,
+		},
+		{
+			name:       "Valid/FailTakesPrecedence",
+			subjects:   []oscalTypes.SubjectReference{subject(extensions.ResultPass), subject(extensions.ResultFail), subject(extensions.ResultError)},
+			wantResult: extensions.ResultFail,
+			wantFound:  true,
+		},
+		{
+			name:       "Valid/ErrorOverPass",
+			subjects:   []oscalTypes.SubjectReference{subject(extensions.ResultPass), subject(extensions.ResultError)},
+			wantResult: extensions.ResultError,
+			wantFound:  true,
+		},
+		{
+			name:       "Valid/PassWithNotApplicable",
+			subjects:   []oscalTypes.SubjectReference{subject(extensions.ResultNotApplicable), subject(extensions.ResultPass), {}},
+			wantResult: extensions.ResultPass,
+			wantFound:  true,
+		},
+		{
+			name:       "Valid/AllNotApplicable",
+			subjects:   []oscalTypes.SubjectReference{subject(extensions.ResultNotApplicable)},
+			wantResult: extensions.ResultNotApplicable,
+			wantFound:  true,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			observation := oscalTypes.Observation{Subjects: &c.subjects}
+			result, found := ObservationResult(observation)
+			require.Equal(t, c.wantFound, found)
+			require.Equal(t, c.wantResult, result)
+		})
+	}
+}
+
+func TestSubjectEvidence(t *testing.T) {
+	subject := oscalTypes.SubjectReference{
+		Links: &[]oscalTypes.Link{
+			{
+				Href: "https://example.com/docs",
+				Rel:  "reference",
+			},
+		},
+	}
+	SetSubjectResult(&subject, extensions.ResultFail, "missing label")
+	AddSubjectEvidence(&subject, "https://example.com/evidence.json")
+
+	result, found := SubjectResult(subject)
+	require.True(t, found)
+	require.Equal(t, extensions.ResultFail, result)
+	require.Equal(t, "missing label", SubjectReason(subject))
+	require.Equal(t, []string{"https://example.com/evidence.json"}, SubjectEvidence(subject))
+}
@@ -0,0 +1,7 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package ssps defines logic for working with OSCAL System Security Plans.
+package ssps
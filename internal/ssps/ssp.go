@@ -0,0 +1,346 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssps
+
+import (
+	"errors"
+	"fmt"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+	"github.com/oscal-compass/oscal-sdk-go/models/modelutils"
+)
+
+const (
+	thisSystemTitle = "This System"
+	defaultState    = "operational"
+)
+
+// ErrNoImplementations defines an error returned when none of the input components
+// implement any requirements.
+var ErrNoImplementations = errors.New("no control implementations found for components")
+
+type generateOpts struct {
+	title         string
+	importProfile string
+	description   string
+	uuid          models.UUIDFunc
+	clock         models.Clock
+	org           *models.Organization
+}
+
+func (g *generateOpts) defaults() {
+	g.title = models.SampleRequiredString
+	g.importProfile = models.SampleRequiredString
+	g.description = models.SampleRequiredString
+	g.uuid = models.RandomUUID
+	g.clock = models.SystemClock
+}
+
+// GenerateOption defines an option to tune the behavior of the
+// GenerateSystemSecurityPlan function.
+type GenerateOption func(opts *generateOpts)
+
+// WithTitle is a GenerateOption that sets the SystemSecurityPlan title
+// in the metadata.
+func WithTitle(title string) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.title = title
+	}
+}
+
+// WithImport is a GenerateOption that sets the Profile
+// ImportProfile Href value.
+func WithImport(importProfile string) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.importProfile = importProfile
+	}
+}
+
+// WithDescription is a GenerateOption that sets the SystemSecurityPlan
+// ControlImplementation description.
+func WithDescription(description string) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.description = description
+	}
+}
+
+// WithUUIDFunc is a GenerateOption that sets the source of UUIDs for the
+// SystemSecurityPlan. Use models.ContentUUID to generate the same UUIDs for the same inputs.
+func WithUUIDFunc(uuidFunc models.UUIDFunc) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.uuid = uuidFunc
+	}
+}
+
+// WithClock is a GenerateOption that sets the source of timestamps for the
+// SystemSecurityPlan metadata.
+func WithClock(clock models.Clock) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.clock = clock
+	}
+}
+
+// WithOrganization is a GenerateOption that adds the organization generating the
+// SystemSecurityPlan to the metadata parties with the creator role.
+func WithOrganization(org models.Organization) GenerateOption {
+	return func(opts *generateOpts) {
+		opts.org = &org
+	}
+}
+
+// ComponentImplementation defines a Component with the associated control
+// implementations for a single framework.
+type ComponentImplementation struct {
+	// Component is the component implementing the requirements.
+	Component components.Component
+	// Implementations are the control implementations for the component.
+	Implementations []components.Implementation
+}
+
+// GenerateSystemSecurityPlan generates a SystemSecurityPlan for a set of Components and the associated control implementations.
+//
+// Each component is added as a system component. Implemented requirements are created per control with a by-component
+// entry for each component implementing the control. The by-component entries carry the rule properties and the set-parameters from the
+// component control implementation and requirement, with the requirement values taking precedence. The set-parameters are not
+// merged into the implemented requirement, so components implementing the same control keep their own values. Any system
+// characteristics are populated with placeholder values.
+func GenerateSystemSecurityPlan(comps []ComponentImplementation, opts ...GenerateOption) (*oscalTypes.SystemSecurityPlan, error) {
+	options := generateOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	thisSystem := oscalTypes.SystemComponent{
+		UUID:        options.uuid("component/This System"),
+		Type:        string(components.ThisSystem),
+		Title:       thisSystemTitle,
+		Description: models.SampleRequiredString,
+		Status: oscalTypes.SystemComponentStatus{
+			State: defaultState,
+		},
+	}
+	systemComponents := []oscalTypes.SystemComponent{thisSystem}
+
+	requirements := newRequirementsIndex(options.uuid)
+	for _, comp := range comps {
+		sysComp, ok := comp.Component.AsSystemComponent()
+		if !ok {
+			continue
+		}
+		systemComponents = append(systemComponents, sysComp)
+		for _, implementation := range comp.Implementations {
+			requirements.addImplementation(sysComp.UUID, implementation)
+		}
+	}
+
+	implementedRequirements := requirements.implementedRequirements()
+	if len(implementedRequirements) == 0 {
+		return nil, ErrNoImplementations
+	}
+
+	metadata := models.NewSampleMetadata()
+	metadata.Title = options.title
+	metadata.LastModified = options.clock()
+	if options.org != nil {
+		models.SetOrganization(&metadata, *options.org)
+	}
+
+	ssp := &oscalTypes.SystemSecurityPlan{
+		UUID:     options.uuid("system-security-plan"),
+		Metadata: metadata,
+		ImportProfile: oscalTypes.ImportProfile{
+			Href: options.importProfile,
+		},
+		SystemCharacteristics: sampleSystemCharacteristics(),
+		SystemImplementation: oscalTypes.SystemImplementation{
+			Components: systemComponents,
+			Users: []oscalTypes.SystemUser{
+				{
+					UUID: options.uuid("user"),
+				},
+			},
+		},
+		ControlImplementation: oscalTypes.ControlImplementation{
+			Description:             options.description,
+			ImplementedRequirements: implementedRequirements,
+		},
+	}
+	return ssp, nil
+}
+
+// requirementsIndex aggregates implemented requirements by control ID
+// for all components.
+type requirementsIndex struct {
+	controlIds     []string
+	byControl      map[string]*oscalTypes.ImplementedRequirement
+	statementIndex map[string]map[string]int
+	uuid           models.UUIDFunc
+}
+
+func newRequirementsIndex(uuidFunc models.UUIDFunc) *requirementsIndex {
+	return &requirementsIndex{
+		byControl:      make(map[string]*oscalTypes.ImplementedRequirement),
+		statementIndex: make(map[string]map[string]int),
+		uuid:           uuidFunc,
+	}
+}
+
+// addImplementation adds by-component entries for a component to the implemented
+// requirements in a control implementation.
+func (r *requirementsIndex) addImplementation(componentUUID string, implementation components.Implementation) {
+	for _, requirement := range implementation.Requirements() {
+		implementedReq := r.getOrCreate(requirement.ControlID())
+
+		// Requirement parameters take precedence over implementation parameters.
+		setParameters := mergeSetParameters(implementation.SetParameters(), requirement.SetParameters())
+		ruleProps := extensions.FindAllProps(requirement.Props(), extensions.WithName(extensions.RuleIdProp))
+		byComp := r.newByComponent(requirement.ControlID(), componentUUID, ruleProps, setParameters)
+		*implementedReq.ByComponents = append(*implementedReq.ByComponents, byComp)
+
+		for _, stm := range requirement.Statements() {
+			stmRuleProps := extensions.FindAllProps(stm.Props(), extensions.WithName(extensions.RuleIdProp))
+			if len(stmRuleProps) == 0 {
+				continue
+			}
+			var stmSetParameters []oscalTypes.SetParameter
+			if parameterized, ok := stm.(components.ParameterizedStatement); ok {
+				stmSetParameters = parameterized.SetParameters()
+			}
+			statement := r.getOrCreateStatement(requirement.ControlID(), stm.StatementID())
+			stmByComp := r.newByComponent(stm.StatementID(), componentUUID, stmRuleProps, stmSetParameters)
+			*statement.ByComponents = append(*statement.ByComponents, stmByComp)
+		}
+	}
+}
+
+// getOrCreate returns the existing implemented requirement for a control ID or a newly created one.
+func (r *requirementsIndex) getOrCreate(controlId string) *oscalTypes.ImplementedRequirement {
+	implementedReq, ok := r.byControl[controlId]
+	if !ok {
+		implementedReq = &oscalTypes.ImplementedRequirement{
+			UUID:         r.uuid(fmt.Sprintf("implemented-requirement/%s", controlId)),
+			ControlId:    controlId,
+			ByComponents: &[]oscalTypes.ByComponent{},
+		}
+		r.byControl[controlId] = implementedReq
+		r.controlIds = append(r.controlIds, controlId)
+	}
+	return implementedReq
+}
+
+// getOrCreateStatement returns the existing statement for a control ID and statement ID or a newly created one.
+func (r *requirementsIndex) getOrCreateStatement(controlId, statementId string) *oscalTypes.Statement {
+	implementedReq := r.getOrCreate(controlId)
+	if implementedReq.Statements == nil {
+		implementedReq.Statements = &[]oscalTypes.Statement{}
+	}
+	statements, ok := r.statementIndex[controlId]
+	if !ok {
+		statements = make(map[string]int)
+		r.statementIndex[controlId] = statements
+	}
+	idx, ok := statements[statementId]
+	if !ok {
+		statement := oscalTypes.Statement{
+			UUID:         r.uuid(fmt.Sprintf("statement/%s/%s", controlId, statementId)),
+			StatementId:  statementId,
+			ByComponents: &[]oscalTypes.ByComponent{},
+		}
+		*implementedReq.Statements = append(*implementedReq.Statements, statement)
+		idx = len(*implementedReq.Statements) - 1
+		statements[statementId] = idx
+	}
+	return &(*implementedReq.Statements)[idx]
+}
+
+// implementedRequirements returns all implemented requirements in the order
+// the controls were added.
+func (r *requirementsIndex) implementedRequirements() []oscalTypes.ImplementedRequirement {
+	implementedReqs := make([]oscalTypes.ImplementedRequirement, 0, len(r.controlIds))
+	for _, controlId := range r.controlIds {
+		implementedReqs = append(implementedReqs, *r.byControl[controlId])
+	}
+	return implementedReqs
+}
+
+// newByComponent returns a ByComponent for a given component in a requirement or statement with
+// the given properties and set-parameters.
+func (r *requirementsIndex) newByComponent(implementedId, componentUUID string, props []oscalTypes.Property, setParameters []oscalTypes.SetParameter) oscalTypes.ByComponent {
+	return oscalTypes.ByComponent{
+		UUID:          r.uuid(fmt.Sprintf("by-component/%s/%s", implementedId, componentUUID)),
+		ComponentUuid: componentUUID,
+		Description:   models.SampleRequiredString,
+		Props:         modelutils.NilIfEmpty(&props),
+		SetParameters: modelutils.NilIfEmpty(&setParameters),
+	}
+}
+
+// mergeSetParameters returns the combined set-parameters with values from
+// overrides replacing any in base with the same parameter ID.
+func mergeSetParameters(base, overrides []oscalTypes.SetParameter) []oscalTypes.SetParameter {
+	var merged []oscalTypes.SetParameter
+	indexById := make(map[string]int)
+	for _, parameters := range [][]oscalTypes.SetParameter{base, overrides} {
+		for _, prm := range parameters {
+			idx, ok := indexById[prm.ParamId]
+			if ok {
+				merged[idx] = prm
+				continue
+			}
+			indexById[prm.ParamId] = len(merged)
+			merged = append(merged, prm)
+		}
+	}
+	return merged
+}
+
+// sampleSystemCharacteristics returns SystemCharacteristics with default
+// values for all required fields.
+func sampleSystemCharacteristics() oscalTypes.SystemCharacteristics {
+	return oscalTypes.SystemCharacteristics{
+		SystemName:  models.SampleRequiredString,
+		Description: models.SampleRequiredString,
+		SystemIds: []oscalTypes.SystemId{
+			{
+				ID: models.SampleRequiredString,
+			},
+		},
+		SecuritySensitivityLevel: models.SampleRequiredString,
+		SystemInformation: oscalTypes.SystemInformation{
+			InformationTypes: []oscalTypes.InformationType{
+				{
+					Title:       models.SampleRequiredString,
+					Description: models.SampleRequiredString,
+					ConfidentialityImpact: &oscalTypes.Impact{
+						Base: models.SampleRequiredString,
+					},
+					IntegrityImpact: &oscalTypes.Impact{
+						Base: models.SampleRequiredString,
+					},
+					AvailabilityImpact: &oscalTypes.Impact{
+						Base: models.SampleRequiredString,
+					},
+				},
+			},
+		},
+		SecurityImpactLevel: &oscalTypes.SecurityImpactLevel{
+			SecurityObjectiveConfidentiality: models.SampleRequiredString,
+			SecurityObjectiveIntegrity:       models.SampleRequiredString,
+			SecurityObjectiveAvailability:    models.SampleRequiredString,
+		},
+		Status: oscalTypes.Status{
+			State: defaultState,
+		},
+		AuthorizationBoundary: oscalTypes.AuthorizationBoundary{
+			Description: models.SampleRequiredString,
+		},
+	}
+}
@@ -0,0 +1,168 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package ssps
+
+import (
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+	"github.com/oscal-compass/oscal-sdk-go/settings"
+)
+
+func TestGenerateSystemSecurityPlan(t *testing.T) {
+	ruleProp := func(value string) oscalTypes.Property {
+		return oscalTypes.Property{
+			Name:  extensions.RuleIdProp,
+			Value: value,
+			Ns:    extensions.TrestleNameSpace,
+		}
+	}
+	compA := oscalTypes.DefinedComponent{
+		UUID:  "a",
+		Title: "Component A",
+		Type:  string(components.Service),
+	}
+	compB := oscalTypes.DefinedComponent{
+		UUID:  "b",
+		Title: "Component B",
+		Type:  string(components.Software),
+	}
+	implementationA := oscalTypes.ControlImplementationSet{
+		SetParameters: &[]oscalTypes.SetParameter{
+			{ParamId: "param-1", Values: []string{"implementation"}},
+			{ParamId: "param-2", Values: []string{"implementation"}},
+		},
+		ImplementedRequirements: []oscalTypes.ImplementedRequirementControlImplementation{
+			{
+				ControlId: "ex-1",
+				Props:     &[]oscalTypes.Property{ruleProp("rule-1")},
+				SetParameters: &[]oscalTypes.SetParameter{
+					{ParamId: "param-1", Values: []string{"requirement"}},
+				},
+				Statements: &[]oscalTypes.ControlStatementImplementation{
+					{
+						StatementId: "ex-1_smt.a",
+						Props:       &[]oscalTypes.Property{ruleProp("rule-2")},
+					},
+					{
+						StatementId: "ex-1_smt.b",
+					},
+				},
+			},
+		},
+	}
+	implementationB := oscalTypes.ControlImplementationSet{
+		ImplementedRequirements: []oscalTypes.ImplementedRequirementControlImplementation{
+			{
+				ControlId: "ex-1",
+				Props:     &[]oscalTypes.Property{ruleProp("rule-3")},
+			},
+			{
+				ControlId: "ex-2",
+				Props:     &[]oscalTypes.Property{ruleProp("rule-3")},
+			},
+		},
+	}
+
+	input := []ComponentImplementation{
+		{
+			Component:       components.NewDefinedComponentAdapter(compA),
+			Implementations: []components.Implementation{components.NewControlImplementationSetAdapter(implementationA)},
+		},
+		{
+			Component:       components.NewDefinedComponentAdapter(compB),
+			Implementations: []components.Implementation{components.NewControlImplementationSetAdapter(implementationB)},
+		},
+	}
+
+	ssp, err := GenerateSystemSecurityPlan(input, WithTitle("mytitle"), WithImport("myprofile"))
+	require.NoError(t, err)
+	require.Equal(t, "mytitle", ssp.Metadata.Title)
+	require.Equal(t, "myprofile", ssp.ImportProfile.Href)
+	require.Equal(t, models.SampleRequiredString, ssp.ControlImplementation.Description)
+
+	require.Len(t, ssp.SystemImplementation.Components, 3)
+	require.Equal(t, string(components.ThisSystem), ssp.SystemImplementation.Components[0].Type)
+
+	implementedReqs := ssp.ControlImplementation.ImplementedRequirements
+	require.Len(t, implementedReqs, 2)
+	require.Equal(t, "ex-1", implementedReqs[0].ControlId)
+	require.Equal(t, "ex-2", implementedReqs[1].ControlId)
+
+	byComps := *implementedReqs[0].ByComponents
+	require.Len(t, byComps, 2)
+	require.Equal(t, "a", byComps[0].ComponentUuid)
+	require.Equal(t, []oscalTypes.Property{ruleProp("rule-1")}, *byComps[0].Props)
+	expectedParameters := []oscalTypes.SetParameter{
+		{ParamId: "param-1", Values: []string{"requirement"}},
+		{ParamId: "param-2", Values: []string{"implementation"}},
+	}
+	require.Equal(t, expectedParameters, *byComps[0].SetParameters)
+	require.Equal(t, "b", byComps[1].ComponentUuid)
+	require.Nil(t, byComps[1].SetParameters)
+	require.Nil(t, implementedReqs[0].SetParameters)
+
+	require.NotNil(t, implementedReqs[0].Statements)
+	statements := *implementedReqs[0].Statements
+	require.Len(t, statements, 1)
+	require.Equal(t, "ex-1_smt.a", statements[0].StatementId)
+	require.Len(t, *statements[0].ByComponents, 1)
+	require.Nil(t, implementedReqs[1].Statements)
+
+	_, err = GenerateSystemSecurityPlan(nil)
+	require.ErrorIs(t, err, ErrNoImplementations)
+}
+
+func TestGenerateSystemSecurityPlan_ConflictingComponents(t *testing.T) {
+	implementation := func(ruleId, value string) components.Implementation {
+		return components.NewControlImplementationSetAdapter(oscalTypes.ControlImplementationSet{
+			ImplementedRequirements: []oscalTypes.ImplementedRequirementControlImplementation{
+				{
+					ControlId: "ex-1",
+					Props: &[]oscalTypes.Property{
+						{Name: extensions.RuleIdProp, Value: ruleId, Ns: extensions.TrestleNameSpace},
+					},
+					SetParameters: &[]oscalTypes.SetParameter{
+						{ParamId: "param-1", Values: []string{value}},
+					},
+				},
+			},
+		})
+	}
+	input := []ComponentImplementation{
+		{
+			Component:       components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{UUID: "a", Title: "Component A", Type: string(components.Service)}),
+			Implementations: []components.Implementation{implementation("rule-a", "value-a")},
+		},
+		{
+			Component:       components.NewDefinedComponentAdapter(oscalTypes.DefinedComponent{UUID: "b", Title: "Component B", Type: string(components.Service)}),
+			Implementations: []components.Implementation{implementation("rule-b", "value-b")},
+		},
+	}
+
+	ssp, err := GenerateSystemSecurityPlan(input)
+	require.NoError(t, err)
+	implementedReqs := ssp.ControlImplementation.ImplementedRequirements
+	require.Len(t, implementedReqs, 1)
+	require.Nil(t, implementedReqs[0].SetParameters)
+
+	// Requirement values apply to the parameters not set by a component
+	implementedReqs[0].SetParameters = &[]oscalTypes.SetParameter{
+		{ParamId: "param-1", Values: []string{"requirement"}},
+		{ParamId: "param-2", Values: []string{"requirement"}},
+	}
+	implementationSettings := settings.NewImplementationSettings(components.NewControlImplementationAdapter(ssp.ControlImplementation))
+	for ruleId, expected := range map[string]string{"rule-a": "value-a", "rule-b": "value-b"} {
+		ruleSettings, err := implementationSettings.ApplicableSettings(ruleId, "ex-1")
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"param-1": expected, "param-2": "requirement"}, ruleSettings.SelectedParameters())
+	}
+}
@@ -0,0 +1,329 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"mime"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+)
+
+const (
+	// SHA256 is the OSCAL hash algorithm name for SHA-256.
+	SHA256 = "SHA-256"
+	// SHA512 is the OSCAL hash algorithm name for SHA-512.
+	SHA512 = "SHA-512"
+)
+
+var (
+	// ErrUnsupportedAlgorithm defines an error returned when a hash algorithm is not supported.
+	ErrUnsupportedAlgorithm = errors.New("unsupported hash algorithm")
+	// ErrHashMismatch defines an error returned when the content of a resource link does not match
+	// the hashes in the link.
+	ErrHashMismatch = errors.New("hash mismatch")
+)
+
+type resourceOpts struct {
+	uuid UUIDFunc
+}
+
+func (r *resourceOpts) defaults() {
+	r.uuid = RandomUUID
+}
+
+// ResourceOption defines an option to tune the behavior of the AddResource function.
+type ResourceOption func(opts *resourceOpts)
+
+// WithResourceUUIDFunc is a ResourceOption that sets the source of the UUID given to a Resource
+// without a UUID. The content passed to the UUIDFunc is derived from the links and embedded content
+// of the Resource, so ContentUUID returns the same UUID for the same Resource.
+func WithResourceUUIDFunc(uuidFunc UUIDFunc) ResourceOption {
+	return func(opts *resourceOpts) {
+		opts.uuid = uuidFunc
+	}
+}
+
+// AddResource adds a Resource to the BackMatter and returns the Resource stored in the BackMatter. If the BackMatter
+// already has a Resource with the same UUID, or with the same links, link hashes and embedded content, the existing
+// Resource is returned instead so references can use its UUID. A Resource without a UUID is given a new UUID, which
+// is random unless `WithResourceUUIDFunc` is set.
+func AddResource(backMatter *oscalTypes.BackMatter, resource oscalTypes.Resource, opts ...ResourceOption) oscalTypes.Resource {
+	options := resourceOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if backMatter.Resources == nil {
+		backMatter.Resources = &[]oscalTypes.Resource{}
+	}
+	key := resourceKey(resource)
+	for _, existing := range *backMatter.Resources {
+		if (resource.UUID != "" && existing.UUID == resource.UUID) || (key != "" && resourceKey(existing) == key) {
+			return existing
+		}
+	}
+	if resource.UUID == "" {
+		resource.UUID = options.uuid(fmt.Sprintf("resource/%s", key))
+	}
+	*backMatter.Resources = append(*backMatter.Resources, resource)
+	return resource
+}
+
+// FindResource returns the Resource with the given UUID in the BackMatter. References in
+// the form `#uuid` are also accepted.
+func FindResource(backMatter oscalTypes.BackMatter, resourceUUID string) (oscalTypes.Resource, bool) {
+	resourceUUID = strings.TrimPrefix(resourceUUID, "#")
+	if backMatter.Resources != nil {
+		for _, resource := range *backMatter.Resources {
+			if resource.UUID == resourceUUID {
+				return resource, true
+			}
+		}
+	}
+	return oscalTypes.Resource{}, false
+}
+
+// FindResourceByHref returns the first Resource in the BackMatter with a link to the given href.
+func FindResourceByHref(backMatter oscalTypes.BackMatter, href string) (oscalTypes.Resource, bool) {
+	if backMatter.Resources != nil {
+		for _, resource := range *backMatter.Resources {
+			if resource.Rlinks == nil {
+				continue
+			}
+			for _, rlink := range *resource.Rlinks {
+				if rlink.Href == href {
+					return resource, true
+				}
+			}
+		}
+	}
+	return oscalTypes.Resource{}, false
+}
+
+// DedupeResources removes Resources with the same UUID, or with the same links, link hashes and embedded content, keeping the
+// first occurrence. A mapping of the removed Resource UUIDs to the kept Resource UUIDs is returned so references
+// to removed Resources can be updated.
+func DedupeResources(backMatter *oscalTypes.BackMatter) map[string]string {
+	remapped := make(map[string]string)
+	if backMatter.Resources == nil {
+		return remapped
+	}
+	var resources []oscalTypes.Resource
+	for _, resource := range *backMatter.Resources {
+		var duplicate *oscalTypes.Resource
+		key := resourceKey(resource)
+		for i, kept := range resources {
+			if (resource.UUID != "" && kept.UUID == resource.UUID) || (key != "" && resourceKey(kept) == key) {
+				duplicate = &resources[i]
+				break
+			}
+		}
+		if duplicate == nil {
+			resources = append(resources, resource)
+			continue
+		}
+		if duplicate.UUID != resource.UUID {
+			remapped[resource.UUID] = duplicate.UUID
+		}
+	}
+	backMatter.Resources = &resources
+	return remapped
+}
+
+// EmbedFile embeds the content of a local file in the Resource as base64. The media type is
+// derived from the file extension.
+func EmbedFile(resource *oscalTypes.Resource, path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to embed file %q: %w", path, err)
+	}
+	resource.Base64 = &oscalTypes.Base64{
+		Filename:  filepath.Base(path),
+		MediaType: mediaType(path),
+		Value:     base64.StdEncoding.EncodeToString(content),
+	}
+	return nil
+}
+
+// NewResourceLink returns a ResourceLink to the href with the hashes of the given content. The media
+// type is derived from the href extension. If no algorithms are given, a SHA-256 hash is added.
+func NewResourceLink(href string, content io.Reader, algorithms ...string) (oscalTypes.ResourceLink, error) {
+	if len(algorithms) == 0 {
+		algorithms = []string{SHA256}
+	}
+	hashes, err := computeHashes(content, algorithms)
+	if err != nil {
+		return oscalTypes.ResourceLink{}, fmt.Errorf("failed to hash content for %q: %w", href, err)
+	}
+	return oscalTypes.ResourceLink{
+		Href:      href,
+		MediaType: mediaType(href),
+		Hashes:    &hashes,
+	}, nil
+}
+
+// VerifyResourceLink returns an error if the content does not match all the hashes
+// in the ResourceLink.
+func VerifyResourceLink(rlink oscalTypes.ResourceLink, content io.Reader) error {
+	if rlink.Hashes == nil || len(*rlink.Hashes) == 0 {
+		return nil
+	}
+	var algorithms []string
+	for _, want := range *rlink.Hashes {
+		algorithms = append(algorithms, want.Algorithm)
+	}
+	got, err := computeHashes(content, algorithms)
+	if err != nil {
+		return fmt.Errorf("failed to verify %q: %w", rlink.Href, err)
+	}
+	for i, want := range *rlink.Hashes {
+		if !strings.EqualFold(want.Value, got[i].Value) {
+			return fmt.Errorf("%w: %s for %q", ErrHashMismatch, want.Algorithm, rlink.Href)
+		}
+	}
+	return nil
+}
+
+// VerifyResources verifies the hashes of all resource links in the BackMatter that reference local files. Relative
+// paths are resolved from baseDir. Links to remote locations or without hashes are skipped. All verification
+// failures are returned.
+func VerifyResources(backMatter oscalTypes.BackMatter, baseDir string) error {
+	if backMatter.Resources == nil {
+		return nil
+	}
+	var errs []error
+	for _, resource := range *backMatter.Resources {
+		if resource.Rlinks == nil {
+			continue
+		}
+		for _, rlink := range *resource.Rlinks {
+			if rlink.Hashes == nil || len(*rlink.Hashes) == 0 {
+				continue
+			}
+			path, ok := localPath(rlink.Href, baseDir)
+			if !ok {
+				continue
+			}
+			if err := verifyFile(rlink, path); err != nil {
+				errs = append(errs, fmt.Errorf("resource %s: %w", resource.UUID, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func verifyFile(rlink oscalTypes.ResourceLink, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to verify %q: %w", rlink.Href, err)
+	}
+	defer file.Close()
+	return VerifyResourceLink(rlink, file)
+}
+
+// localPath returns the file path for an href to a local file.
+func localPath(href, baseDir string) (string, bool) {
+	if href == "" || strings.HasPrefix(href, "#") {
+		return "", false
+	}
+	parsed, err := url.Parse(href)
+	if err != nil {
+		return "", false
+	}
+	switch parsed.Scheme {
+	case "":
+		if filepath.IsAbs(parsed.Path) {
+			return parsed.Path, true
+		}
+		return filepath.Join(baseDir, filepath.FromSlash(parsed.Path)), true
+	case "file":
+		return filepath.FromSlash(parsed.Path), true
+	default:
+		return "", false
+	}
+}
+
+func computeHashes(content io.Reader, algorithms []string) ([]oscalTypes.Hash, error) {
+	hashers := make([]hash.Hash, 0, len(algorithms))
+	writers := make([]io.Writer, 0, len(algorithms))
+	for _, algorithm := range algorithms {
+		var hasher hash.Hash
+		switch strings.ToUpper(algorithm) {
+		case SHA256:
+			hasher = sha256.New()
+		case SHA512:
+			hasher = sha512.New()
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+		}
+		hashers = append(hashers, hasher)
+		writers = append(writers, hasher)
+	}
+	if _, err := io.Copy(io.MultiWriter(writers...), content); err != nil {
+		return nil, err
+	}
+	hashes := make([]oscalTypes.Hash, 0, len(algorithms))
+	for i, hasher := range hashers {
+		hashes = append(hashes, oscalTypes.Hash{
+			Algorithm: algorithms[i],
+			Value:     hex.EncodeToString(hasher.Sum(nil)),
+		})
+	}
+	return hashes, nil
+}
+
+// resourceKey returns a key identifying a Resource by the links, link hashes and embedded content,
+// or an empty string if the Resource has neither links nor embedded content. Links to the same href
+// with different hashes reference different content and have different keys.
+func resourceKey(resource oscalTypes.Resource) string {
+	var parts []string
+	if resource.Rlinks != nil {
+		for _, rlink := range *resource.Rlinks {
+			part := rlink.Href
+			if rlink.Hashes != nil && len(*rlink.Hashes) > 0 {
+				var hashes []string
+				for _, h := range *rlink.Hashes {
+					hashes = append(hashes, fmt.Sprintf("%s=%s", strings.ToUpper(h.Algorithm), strings.ToLower(h.Value)))
+				}
+				sort.Strings(hashes)
+				part = fmt.Sprintf("%s#%s", part, strings.Join(hashes, ","))
+			}
+			parts = append(parts, part)
+		}
+		sort.Strings(parts)
+	}
+	if resource.Base64 != nil {
+		sum := sha256.Sum256([]byte(resource.Base64.Value))
+		parts = append(parts, fmt.Sprintf("base64:%s:%x", resource.Base64.Filename, sum))
+	}
+	return strings.Join(parts, "|")
+}
+
+func mediaType(path string) string {
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		return "application/json"
+	case ".yaml", ".yml":
+		return "application/yaml"
+	default:
+		mediaType, _, _ := strings.Cut(mime.TypeByExtension(ext), ";")
+		return mediaType
+	}
+}
@@ -0,0 +1,186 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddResource(t *testing.T) {
+	backMatter := oscalTypes.BackMatter{}
+	profile := oscalTypes.Resource{
+		UUID:   "11111111-1111-4111-8111-111111111111",
+		Title:  "profile",
+		Rlinks: &[]oscalTypes.ResourceLink{{Href: "profiles/cis/profile.json"}},
+	}
+
+	added := AddResource(&backMatter, profile)
+	require.Equal(t, profile, added)
+
+	// Same links return the existing resource
+	duplicate := profile
+	duplicate.UUID = "22222222-2222-4222-8222-222222222222"
+	require.Equal(t, profile.UUID, AddResource(&backMatter, duplicate).UUID)
+
+	// A resource without a UUID is given one
+	evidence := AddResource(&backMatter, oscalTypes.Resource{
+		Title:  "evidence",
+		Rlinks: &[]oscalTypes.ResourceLink{{Href: "evidence/output.txt"}},
+	})
+	require.NotEmpty(t, evidence.UUID)
+	require.Len(t, *backMatter.Resources, 2)
+
+	// Links to the same href with different hashes are different resources
+	hashed := AddResource(&backMatter, oscalTypes.Resource{
+		Rlinks: &[]oscalTypes.ResourceLink{{Href: "evidence/output.txt", Hashes: &[]oscalTypes.Hash{{Algorithm: SHA256, Value: "AB"}}}},
+	})
+	require.NotEqual(t, evidence.UUID, hashed.UUID)
+	require.Equal(t, hashed.UUID, AddResource(&backMatter, oscalTypes.Resource{
+		Rlinks: &[]oscalTypes.ResourceLink{{Href: "evidence/output.txt", Hashes: &[]oscalTypes.Hash{{Algorithm: "sha-256", Value: "ab"}}}},
+	}).UUID)
+	require.Len(t, *backMatter.Resources, 3)
+
+	found, ok := FindResource(backMatter, "#"+evidence.UUID)
+	require.True(t, ok)
+	require.Equal(t, "evidence", found.Title)
+	found, ok = FindResourceByHref(backMatter, "profiles/cis/profile.json")
+	require.True(t, ok)
+	require.Equal(t, profile.UUID, found.UUID)
+	_, ok = FindResource(backMatter, "33333333-3333-4333-8333-333333333333")
+	require.False(t, ok)
+}
+
+func TestAddResource_WithResourceUUIDFunc(t *testing.T) {
+	add := func() oscalTypes.Resource {
+		backMatter := oscalTypes.BackMatter{}
+		return AddResource(&backMatter, oscalTypes.Resource{
+			Rlinks: &[]oscalTypes.ResourceLink{{Href: "evidence/output.txt"}},
+		}, WithResourceUUIDFunc(ContentUUID("test")))
+	}
+	require.Equal(t, add().UUID, add().UUID)
+}
+
+func TestDedupeResources(t *testing.T) {
+	backMatter := oscalTypes.BackMatter{
+		Resources: &[]oscalTypes.Resource{
+			{UUID: "resource-1", Rlinks: &[]oscalTypes.ResourceLink{{Href: "a.json"}, {Href: "b.json"}}},
+			{UUID: "resource-2", Rlinks: &[]oscalTypes.ResourceLink{{Href: "b.json"}, {Href: "a.json"}}},
+			{UUID: "resource-3", Base64: &oscalTypes.Base64{Filename: "a.txt", Value: "YQ=="}},
+			{UUID: "resource-4", Base64: &oscalTypes.Base64{Filename: "a.txt", Value: "YQ=="}},
+			{UUID: "resource-1", Title: "same uuid"},
+			{UUID: "resource-5", Title: "no content"},
+		},
+	}
+	remapped := DedupeResources(&backMatter)
+	require.Equal(t, map[string]string{
+		"resource-2": "resource-1",
+		"resource-4": "resource-3",
+	}, remapped)
+
+	var uuids []string
+	for _, resource := range *backMatter.Resources {
+		uuids = append(uuids, resource.UUID)
+	}
+	require.Equal(t, []string{"resource-1", "resource-3", "resource-5"}, uuids)
+}
+
+func TestEmbedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "evidence.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"result":"pass"}`), 0600))
+
+	resource := oscalTypes.Resource{}
+	require.NoError(t, EmbedFile(&resource, path))
+	require.NotNil(t, resource.Base64)
+	require.Equal(t, "evidence.json", resource.Base64.Filename)
+	require.Equal(t, "application/json", resource.Base64.MediaType)
+	decoded, err := base64.StdEncoding.DecodeString(resource.Base64.Value)
+	require.NoError(t, err)
+	require.Equal(t, `{"result":"pass"}`, string(decoded))
+
+	require.Error(t, EmbedFile(&resource, filepath.Join(t.TempDir(), "missing.json")))
+}
+
+func TestNewResourceLink(t *testing.T) {
+	tests := []struct {
+		name       string
+		algorithms []string
+		wantHashes []oscalTypes.Hash
+		wantError  error
+	}{
+		{
+			name: "Valid/DefaultSHA256",
+			wantHashes: []oscalTypes.Hash{
+				{Algorithm: SHA256, Value: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"},
+			},
+		},
+		{
+			name:       "Valid/SHA256AndSHA512",
+			algorithms: []string{SHA256, SHA512},
+			wantHashes: []oscalTypes.Hash{
+				{Algorithm: SHA256, Value: "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"},
+				{Algorithm: SHA512, Value: "9b71d224bd62f3785d96d46ad3ea3d73319bfbc2890caadae2dff72519673ca72323c3d99ba5c11d7c7acc6e14b8c5da0c4663475c2e5c3adef46f73bcdec043"},
+			},
+		},
+		{
+			name:       "Invalid/UnsupportedAlgorithm",
+			algorithms: []string{"MD5"},
+			wantError:  ErrUnsupportedAlgorithm,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			rlink, err := NewResourceLink("evidence/output.txt", strings.NewReader("hello"), c.algorithms...)
+			if c.wantError != nil {
+				require.ErrorIs(t, err, c.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "evidence/output.txt", rlink.Href)
+			require.Equal(t, "text/plain", rlink.MediaType)
+			require.Equal(t, c.wantHashes, *rlink.Hashes)
+			require.NoError(t, VerifyResourceLink(rlink, strings.NewReader("hello")))
+			require.ErrorIs(t, VerifyResourceLink(rlink, strings.NewReader("goodbye")), ErrHashMismatch)
+		})
+	}
+}
+
+func TestVerifyResources(t *testing.T) {
+	baseDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(baseDir, "evidence.txt"), []byte("hello"), 0600))
+
+	newBackMatter := func(content string) oscalTypes.BackMatter {
+		rlink, err := NewResourceLink("evidence.txt", strings.NewReader(content), SHA512)
+		require.NoError(t, err)
+		backMatter := oscalTypes.BackMatter{}
+		AddResource(&backMatter, oscalTypes.Resource{
+			UUID:   "resource-1",
+			Rlinks: &[]oscalTypes.ResourceLink{rlink},
+		})
+		// Remote links are not verified
+		AddResource(&backMatter, oscalTypes.Resource{
+			UUID: "resource-2",
+			Rlinks: &[]oscalTypes.ResourceLink{
+				{
+					Href:   "https://example.com/evidence.txt",
+					Hashes: &[]oscalTypes.Hash{{Algorithm: SHA256, Value: "invalid"}},
+				},
+			},
+		})
+		return backMatter
+	}
+
+	require.NoError(t, VerifyResources(newBackMatter("hello"), baseDir))
+	require.ErrorIs(t, VerifyResources(newBackMatter("changed"), baseDir), ErrHashMismatch)
+	require.ErrorIs(t, VerifyResources(newBackMatter("hello"), t.TempDir()), os.ErrNotExist)
+}
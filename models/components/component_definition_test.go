@@ -70,6 +70,8 @@ func TestControlImplementationSetAdapter(t *testing.T) {
 
 	statement := impReq.Statements()[0]
 	require.Len(t, statement.Props(), 0)
+	_, ok := statement.(ParameterizedStatement)
+	require.False(t, ok)
 	require.Equal(t, "cb9219b1-e51c-4680-abb0-616a43bbfbb2", statement.UUID())
 	require.Equal(t, "CIS-2.1_smt", statement.StatementID())
 }
@@ -48,3 +48,30 @@ type Statement interface {
 	// Props returns a list of OSCAL properties associated with the statement.
 	Props() []oscalTypes.Property
 }
+
+// ParameterizedStatement is an optional interface for a Statement that sets parameter values
+// specific to the statement.
+type ParameterizedStatement interface {
+	Statement
+	// SetParameters returns a list of OSCAL set-parameters associated with the statement.
+	SetParameters() []oscalTypes.SetParameter
+}
+
+// ByComponent is an interface representing the implementation of a requirement by a single component
+// in an OSCAL SSP.
+type ByComponent interface {
+	// ComponentUUID returns the UUID of the component implementing the requirement.
+	ComponentUUID() string
+	// SetParameters returns a list of OSCAL set-parameters specific to the component.
+	SetParameters() []oscalTypes.SetParameter
+	// Props returns a list of OSCAL properties associated with the component implementation.
+	Props() []oscalTypes.Property
+}
+
+// ComponentRequirement is an optional interface for a Requirement implemented by more than one
+// component with parameter values specific to each component.
+type ComponentRequirement interface {
+	Requirement
+	// ByComponents returns the implementations of the requirement by each component.
+	ByComponents() []ByComponent
+}
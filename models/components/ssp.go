@@ -163,6 +163,49 @@ func (i *ImplementedRequirementAdapter) Statements() []Statement {
 	return statements
 }
 
+func (i *ImplementedRequirementAdapter) ByComponents() []ByComponent {
+	var byComponents []ByComponent
+	if i.impReq.ByComponents == nil {
+		return byComponents
+	}
+	for _, byComp := range *i.impReq.ByComponents {
+		byComponents = append(byComponents, NewByComponentAdapter(byComp))
+	}
+	return byComponents
+}
+
+// ByComponentAdapter wraps an OSCAL ByComponent to provide
+// methods for compatibility with ByComponent.
+type ByComponentAdapter struct {
+	byComp oscalTypes.ByComponent
+}
+
+// NewByComponentAdapter returns an initialized ByComponentAdapter from a given
+// ByComponent from an OSCAL SSP.
+func NewByComponentAdapter(byComp oscalTypes.ByComponent) *ByComponentAdapter {
+	return &ByComponentAdapter{
+		byComp: byComp,
+	}
+}
+
+func (b *ByComponentAdapter) ComponentUUID() string {
+	return b.byComp.ComponentUuid
+}
+
+func (b *ByComponentAdapter) SetParameters() []oscalTypes.SetParameter {
+	if b.byComp.SetParameters == nil {
+		return []oscalTypes.SetParameter{}
+	}
+	return *b.byComp.SetParameters
+}
+
+func (b *ByComponentAdapter) Props() []oscalTypes.Property {
+	if b.byComp.Props == nil {
+		return []oscalTypes.Property{}
+	}
+	return *b.byComp.Props
+}
+
 // StatementAdapter wraps an OSCAL Statement to provide
 // methods for compatibility with Statement.
 type StatementAdapter struct {
@@ -185,6 +228,20 @@ func (s *StatementAdapter) UUID() string {
 	return s.stm.UUID
 }
 
+func (s *StatementAdapter) SetParameters() []oscalTypes.SetParameter {
+	var setParameters []oscalTypes.SetParameter
+	if s.stm.ByComponents == nil {
+		return setParameters
+	}
+
+	for _, byComp := range *s.stm.ByComponents {
+		if byComp.SetParameters != nil {
+			setParameters = append(setParameters, *byComp.SetParameters...)
+		}
+	}
+	return setParameters
+}
+
 func (s *StatementAdapter) Props() []oscalTypes.Property {
 	var oscalProps []oscalTypes.Property
 	if s.stm.Props != nil {
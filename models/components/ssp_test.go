@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 	"github.com/stretchr/testify/require"
 
 	"github.com/oscal-compass/oscal-sdk-go/models"
@@ -60,8 +61,23 @@ func TestControlImplementationAdapter(t *testing.T) {
 	require.Equal(t, "ex-1", impReq.ControlID())
 	require.Len(t, impReq.Statements(), 1)
 
+	// Set-parameters for a by-component are not merged into the requirement
+	byComponentReq := adapter.Requirements()[1]
+	require.Len(t, byComponentReq.SetParameters(), 0)
+	componentReq, ok := byComponentReq.(ComponentRequirement)
+	require.True(t, ok)
+	byComponents := componentReq.ByComponents()
+	require.Len(t, byComponents, 2)
+	require.Equal(t, "4e19131e-b361-4f0e-8262-02bf4456202e", byComponents[0].ComponentUUID())
+	require.Equal(t, []oscalTypes.SetParameter{{ParamId: "param-1", Values: []string{"2"}}}, byComponents[0].SetParameters())
+	require.Len(t, byComponents[0].Props(), 1)
+	require.Len(t, byComponents[1].SetParameters(), 0)
+
 	statement := impReq.Statements()[0]
 	require.Len(t, statement.Props(), 1)
+	parameterized, ok := statement.(ParameterizedStatement)
+	require.True(t, ok)
+	require.Len(t, parameterized.SetParameters(), 0)
 	require.Equal(t, "7ad47329-dc55-4196-a19d-178a8fe7438e", statement.UUID())
 	require.Equal(t, "ex-1_smt", statement.StatementID())
 }
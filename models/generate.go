@@ -0,0 +1,62 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
+)
+
+// UUIDFunc returns a UUID for an object in a generated OSCAL model. The content
+// is a stable description of the object, such as the title of an Activity, that
+// can be used to derive the UUID.
+type UUIDFunc func(content string) string
+
+// RandomUUID is a UUIDFunc that returns a random UUID and ignores the content.
+func RandomUUID(_ string) string {
+	return uuid.NewUUID()
+}
+
+// ContentUUID returns a UUIDFunc that derives a version 5 UUID from the namespace and
+// the content, so generating a model from the same inputs returns the same UUIDs.
+//
+// Content requested more than once returns a different UUID for each occurrence, in
+// order, so objects with the same description still have unique UUIDs. A new UUIDFunc
+// must be created for each generated model to reproduce the UUIDs.
+func ContentUUID(namespace string) UUIDFunc {
+	var mu sync.Mutex
+	seen := make(map[string]int)
+	return func(content string) string {
+		mu.Lock()
+		occurrence := seen[content]
+		seen[content]++
+		mu.Unlock()
+
+		source := fmt.Sprintf("%s/%s", namespace, content)
+		if occurrence > 0 {
+			source = fmt.Sprintf("%s#%d", source, occurrence)
+		}
+		return uuid.NewUUIDWithSource(source)
+	}
+}
+
+// Clock returns the time used for timestamps in a generated OSCAL model.
+type Clock func() time.Time
+
+// SystemClock is a Clock that returns the current time.
+func SystemClock() time.Time {
+	return time.Now()
+}
+
+// FixedClock returns a Clock that always returns the given time.
+func FixedClock(t time.Time) Clock {
+	return func() time.Time {
+		return t
+	}
+}
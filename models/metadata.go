@@ -0,0 +1,276 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/models/modelutils"
+)
+
+const (
+	// CreatorRole is the id of the role for the organization that created a document.
+	CreatorRole  = "creator"
+	organization = "organization"
+)
+
+// ErrInvalidVersion defines an error returned when a document version is not
+// a semantic version.
+var ErrInvalidVersion = errors.New("version is not a semantic version")
+
+// VersionPart defines the part of a semantic version to increment.
+type VersionPart int
+
+const (
+	// Major increments the major version and resets the minor and patch versions.
+	Major VersionPart = iota
+	// Minor increments the minor version and resets the patch version.
+	Minor
+	// Patch increments the patch version.
+	Patch
+)
+
+// BumpVersion increments a part of the semantic version in the Metadata. An optional "v" prefix
+// is kept, and any pre-release or build suffix is removed.
+func BumpVersion(metadata *oscalTypes.Metadata, part VersionPart) error {
+	version := metadata.Version
+	prefix := ""
+	if strings.HasPrefix(version, "v") {
+		prefix = "v"
+		version = strings.TrimPrefix(version, "v")
+	}
+	if idx := strings.IndexAny(version, "-+"); idx != -1 {
+		version = version[:idx]
+	}
+
+	fields := strings.Split(version, ".")
+	if len(fields) != 3 {
+		return fmt.Errorf("%w: %q", ErrInvalidVersion, metadata.Version)
+	}
+	var numbers [3]int
+	for i, field := range fields {
+		number, err := strconv.Atoi(field)
+		if err != nil || number < 0 {
+			return fmt.Errorf("%w: %q", ErrInvalidVersion, metadata.Version)
+		}
+		numbers[i] = number
+	}
+
+	switch part {
+	case Major:
+		numbers = [3]int{numbers[0] + 1, 0, 0}
+	case Minor:
+		numbers = [3]int{numbers[0], numbers[1] + 1, 0}
+	case Patch:
+		numbers[2]++
+	default:
+		return fmt.Errorf("unknown version part %d", part)
+	}
+	metadata.Version = fmt.Sprintf("%s%d.%d.%d", prefix, numbers[0], numbers[1], numbers[2])
+	return nil
+}
+
+// AddRevision adds an entry for the current title, version, and last modified time of the Metadata
+// to the revision history with the given remarks. Call AddRevision after updating the Metadata for a change.
+func AddRevision(metadata *oscalTypes.Metadata, remarks string) {
+	lastModified := metadata.LastModified
+	revision := oscalTypes.RevisionHistoryEntry{
+		Title:        metadata.Title,
+		Version:      metadata.Version,
+		OscalVersion: metadata.OscalVersion,
+		LastModified: &lastModified,
+		Published:    metadata.Published,
+		Remarks:      remarks,
+	}
+	if metadata.Revisions == nil {
+		metadata.Revisions = &[]oscalTypes.RevisionHistoryEntry{}
+	}
+	*metadata.Revisions = append(*metadata.Revisions, revision)
+}
+
+// SetParty adds a Party to the Metadata or replaces the Party with the same UUID.
+func SetParty(metadata *oscalTypes.Metadata, party oscalTypes.Party) {
+	if metadata.Parties == nil {
+		metadata.Parties = &[]oscalTypes.Party{}
+	}
+	for i, existing := range *metadata.Parties {
+		if existing.UUID == party.UUID {
+			(*metadata.Parties)[i] = party
+			return
+		}
+	}
+	*metadata.Parties = append(*metadata.Parties, party)
+}
+
+// FindParty returns the Party with the given UUID in the Metadata.
+func FindParty(metadata oscalTypes.Metadata, partyUUID string) (oscalTypes.Party, bool) {
+	if metadata.Parties != nil {
+		for _, party := range *metadata.Parties {
+			if party.UUID == partyUUID {
+				return party, true
+			}
+		}
+	}
+	return oscalTypes.Party{}, false
+}
+
+// RemoveParty removes the Party with the given UUID from the Metadata and from all
+// responsible parties. Responsible parties left without a Party are removed.
+func RemoveParty(metadata *oscalTypes.Metadata, partyUUID string) {
+	if metadata.Parties != nil {
+		var parties []oscalTypes.Party
+		for _, party := range *metadata.Parties {
+			if party.UUID != partyUUID {
+				parties = append(parties, party)
+			}
+		}
+		metadata.Parties = modelutils.NilIfEmpty(&parties)
+	}
+	if metadata.ResponsibleParties != nil {
+		var responsibleParties []oscalTypes.ResponsibleParty
+		for _, responsibleParty := range *metadata.ResponsibleParties {
+			var partyUUIDs []string
+			for _, existing := range responsibleParty.PartyUuids {
+				if existing != partyUUID {
+					partyUUIDs = append(partyUUIDs, existing)
+				}
+			}
+			if len(partyUUIDs) == 0 {
+				continue
+			}
+			responsibleParty.PartyUuids = partyUUIDs
+			responsibleParties = append(responsibleParties, responsibleParty)
+		}
+		metadata.ResponsibleParties = modelutils.NilIfEmpty(&responsibleParties)
+	}
+}
+
+// SetRole adds a Role to the Metadata or replaces the Role with the same id.
+func SetRole(metadata *oscalTypes.Metadata, role oscalTypes.Role) {
+	if metadata.Roles == nil {
+		metadata.Roles = &[]oscalTypes.Role{}
+	}
+	for i, existing := range *metadata.Roles {
+		if existing.ID == role.ID {
+			(*metadata.Roles)[i] = role
+			return
+		}
+	}
+	*metadata.Roles = append(*metadata.Roles, role)
+}
+
+// FindRole returns the Role with the given id in the Metadata.
+func FindRole(metadata oscalTypes.Metadata, roleId string) (oscalTypes.Role, bool) {
+	if metadata.Roles != nil {
+		for _, role := range *metadata.Roles {
+			if role.ID == roleId {
+				return role, true
+			}
+		}
+	}
+	return oscalTypes.Role{}, false
+}
+
+// RemoveRole removes the Role with the given id and its responsible parties from the Metadata.
+func RemoveRole(metadata *oscalTypes.Metadata, roleId string) {
+	if metadata.Roles != nil {
+		var roles []oscalTypes.Role
+		for _, role := range *metadata.Roles {
+			if role.ID != roleId {
+				roles = append(roles, role)
+			}
+		}
+		metadata.Roles = modelutils.NilIfEmpty(&roles)
+	}
+	if metadata.ResponsibleParties != nil {
+		var responsibleParties []oscalTypes.ResponsibleParty
+		for _, responsibleParty := range *metadata.ResponsibleParties {
+			if responsibleParty.RoleId != roleId {
+				responsibleParties = append(responsibleParties, responsibleParty)
+			}
+		}
+		metadata.ResponsibleParties = modelutils.NilIfEmpty(&responsibleParties)
+	}
+}
+
+// AddResponsibleParty assigns the parties with the given UUIDs to a Role in the Metadata. Parties
+// already assigned to the Role are not added again.
+func AddResponsibleParty(metadata *oscalTypes.Metadata, roleId string, partyUUIDs ...string) {
+	if metadata.ResponsibleParties == nil {
+		metadata.ResponsibleParties = &[]oscalTypes.ResponsibleParty{}
+	}
+	responsibleParties := *metadata.ResponsibleParties
+	idx := -1
+	for i, responsibleParty := range responsibleParties {
+		if responsibleParty.RoleId == roleId {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		responsibleParties = append(responsibleParties, oscalTypes.ResponsibleParty{RoleId: roleId})
+		idx = len(responsibleParties) - 1
+	}
+	for _, partyUUID := range partyUUIDs {
+		found := false
+		for _, existing := range responsibleParties[idx].PartyUuids {
+			if existing == partyUUID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			responsibleParties[idx].PartyUuids = append(responsibleParties[idx].PartyUuids, partyUUID)
+		}
+	}
+	*metadata.ResponsibleParties = responsibleParties
+}
+
+// Organization defines the identity of the organization that generates OSCAL documents.
+type Organization struct {
+	// UUID is the party UUID for the organization. If not set, the UUID is derived from
+	// the Name so the organization has the same UUID in all documents.
+	UUID      string
+	Name      string
+	ShortName string
+	Email     string
+}
+
+// Party returns the organization as an OSCAL Party.
+func (o Organization) Party() oscalTypes.Party {
+	party := oscalTypes.Party{
+		UUID:      o.UUID,
+		Type:      organization,
+		Name:      o.Name,
+		ShortName: o.ShortName,
+	}
+	if party.UUID == "" {
+		party.UUID = uuid.NewUUIDWithSource(fmt.Sprintf("party/%s", o.Name))
+	}
+	if o.Email != "" {
+		party.EmailAddresses = &[]string{o.Email}
+	}
+	return party
+}
+
+// SetOrganization adds the organization as a Party to the Metadata with the creator role.
+func SetOrganization(metadata *oscalTypes.Metadata, org Organization) {
+	party := org.Party()
+	SetParty(metadata, party)
+	if _, found := FindRole(*metadata, CreatorRole); !found {
+		SetRole(metadata, oscalTypes.Role{
+			ID:    CreatorRole,
+			Title: "Document Creator",
+		})
+	}
+	AddResponsibleParty(metadata, CreatorRole, party.UUID)
+}
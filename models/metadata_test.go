@@ -0,0 +1,159 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBumpVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     string
+		part        VersionPart
+		wantVersion string
+		wantError   error
+	}{
+		{
+			name:        "Valid/Major",
+			version:     "1.2.3",
+			part:        Major,
+			wantVersion: "2.0.0",
+		},
+		{
+			name:        "Valid/Minor",
+			version:     "1.2.3",
+			part:        Minor,
+			wantVersion: "1.3.0",
+		},
+		{
+			name:        "Valid/Patch",
+			version:     "1.2.3",
+			part:        Patch,
+			wantVersion: "1.2.4",
+		},
+		{
+			name:        "Valid/PrefixAndPreRelease",
+			version:     "v0.1.0-rc.1+build",
+			part:        Patch,
+			wantVersion: "v0.1.1",
+		},
+		{
+			name:      "Invalid/NotSemver",
+			version:   "REPLACE_ME",
+			part:      Patch,
+			wantError: ErrInvalidVersion,
+		},
+		{
+			name:      "Invalid/NotNumeric",
+			version:   "1.x.0",
+			part:      Minor,
+			wantError: ErrInvalidVersion,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			metadata := oscalTypes.Metadata{Version: c.version}
+			err := BumpVersion(&metadata, c.part)
+			if c.wantError != nil {
+				require.ErrorIs(t, err, c.wantError)
+				require.Equal(t, c.version, metadata.Version)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.wantVersion, metadata.Version)
+		})
+	}
+}
+
+func TestAddRevision(t *testing.T) {
+	metadata := NewSampleMetadata()
+	metadata.Title = "Example"
+	AddRevision(&metadata, "Initial version")
+
+	metadata.LastModified = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, BumpVersion(&metadata, Minor))
+	AddRevision(&metadata, "Added controls")
+
+	require.NotNil(t, metadata.Revisions)
+	revisions := *metadata.Revisions
+	require.Len(t, revisions, 2)
+	require.Equal(t, "0.1.0", revisions[0].Version)
+	require.Equal(t, "Initial version", revisions[0].Remarks)
+	require.Equal(t, "0.2.0", revisions[1].Version)
+	require.Equal(t, "Example", revisions[1].Title)
+	require.Equal(t, "Added controls", revisions[1].Remarks)
+	require.True(t, metadata.LastModified.Equal(*revisions[1].LastModified))
+}
+
+func TestParties(t *testing.T) {
+	metadata := NewSampleMetadata()
+	SetParty(&metadata, oscalTypes.Party{UUID: "party-1", Type: "person", Name: "Alice"})
+	SetParty(&metadata, oscalTypes.Party{UUID: "party-2", Type: "person", Name: "Bob"})
+	SetParty(&metadata, oscalTypes.Party{UUID: "party-1", Type: "person", Name: "Alice Smith"})
+	SetRole(&metadata, oscalTypes.Role{ID: "assessor", Title: "Assessor"})
+	SetRole(&metadata, oscalTypes.Role{ID: "reviewer", Title: "Reviewer"})
+	AddResponsibleParty(&metadata, "assessor", "party-1", "party-2")
+	AddResponsibleParty(&metadata, "assessor", "party-1")
+	AddResponsibleParty(&metadata, "reviewer", "party-2")
+
+	require.Len(t, *metadata.Parties, 2)
+	party, found := FindParty(metadata, "party-1")
+	require.True(t, found)
+	require.Equal(t, "Alice Smith", party.Name)
+	_, found = FindParty(metadata, "party-3")
+	require.False(t, found)
+	require.Equal(t, []oscalTypes.ResponsibleParty{
+		{RoleId: "assessor", PartyUuids: []string{"party-1", "party-2"}},
+		{RoleId: "reviewer", PartyUuids: []string{"party-2"}},
+	}, *metadata.ResponsibleParties)
+
+	RemoveParty(&metadata, "party-2")
+	require.Len(t, *metadata.Parties, 1)
+	require.Equal(t, []oscalTypes.ResponsibleParty{
+		{RoleId: "assessor", PartyUuids: []string{"party-1"}},
+	}, *metadata.ResponsibleParties)
+
+	RemoveRole(&metadata, "assessor")
+	_, found = FindRole(metadata, "assessor")
+	require.False(t, found)
+	role, found := FindRole(metadata, "reviewer")
+	require.True(t, found)
+	require.Equal(t, "Reviewer", role.Title)
+	require.Nil(t, metadata.ResponsibleParties)
+}
+
+func TestSetOrganization(t *testing.T) {
+	org := Organization{
+		Name:      "Example Corp",
+		ShortName: "example",
+		Email:     "compliance@example.com",
+	}
+	metadata := NewSampleMetadata()
+	SetOrganization(&metadata, org)
+	SetOrganization(&metadata, org)
+
+	require.Len(t, *metadata.Parties, 1)
+	party := (*metadata.Parties)[0]
+	require.Equal(t, org.Party(), party)
+	require.Equal(t, "organization", party.Type)
+	require.Equal(t, []string{"compliance@example.com"}, *party.EmailAddresses)
+
+	role, found := FindRole(metadata, CreatorRole)
+	require.True(t, found)
+	require.Equal(t, "Document Creator", role.Title)
+	require.Equal(t, []oscalTypes.ResponsibleParty{
+		{RoleId: CreatorRole, PartyUuids: []string{party.UUID}},
+	}, *metadata.ResponsibleParties)
+
+	org.UUID = "11111111-1111-4111-8111-111111111111"
+	require.Equal(t, org.UUID, org.Party().UUID)
+}
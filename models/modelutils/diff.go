@@ -0,0 +1,267 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package modelutils
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+)
+
+// ChangeType defines the type of change to a value in an OSCAL model.
+type ChangeType string
+
+const (
+	// ChangeAdded is the change type for a value that was added.
+	ChangeAdded ChangeType = "added"
+	// ChangeRemoved is the change type for a value that was removed.
+	ChangeRemoved ChangeType = "removed"
+	// ChangeModified is the change type for a value that was modified.
+	ChangeModified ChangeType = "modified"
+)
+
+// Change defines a change to a value in an OSCAL model.
+type Change struct {
+	// Path is the location of the value using JSON names with list items identified
+	// by key, for example `component-definition.components[uuid=...].title`.
+	Path string     `json:"path"`
+	Type ChangeType `json:"type"`
+	// From is the previous value. It is nil when the value was added.
+	From any `json:"from,omitempty"`
+	// To is the current value. It is nil when the value was removed.
+	To any `json:"to,omitempty"`
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	propertyType = reflect.TypeOf(oscalTypes.Property{})
+)
+
+// keyFields are the JSON names of fields identifying list items in order of precedence.
+var keyFields = []string{"uuid", "control-id", "statement-id", "param-id", "role-id", "id"}
+
+// Diff returns the structural changes between two OSCAL models.
+//
+// List items are matched by UUID or identifying keys instead of by index. Items are identified by the first set
+// field of uuid, any field ending in -uuid, control-id, statement-id, param-id, role-id and id. Properties are
+// identified by name and remarks, and also by value when the remarks are empty or the name and remarks are not
+// unique in the list. Links are identified by href and rel. Lists of items without identifying keys are compared
+// by index and lists of scalar values are compared as a single value.
+func Diff(from, to *oscalTypes.OscalModels) []Change {
+	var changes []Change
+	diffValue("", reflect.ValueOf(from), reflect.ValueOf(to), &changes)
+	return changes
+}
+
+func diffValue(path string, from, to reflect.Value, changes *[]Change) {
+	from, fromOk := present(from)
+	to, toOk := present(to)
+	switch {
+	case !fromOk && !toOk:
+		return
+	case !fromOk:
+		*changes = append(*changes, Change{Path: path, Type: ChangeAdded, To: to.Interface()})
+		return
+	case !toOk:
+		*changes = append(*changes, Change{Path: path, Type: ChangeRemoved, From: from.Interface()})
+		return
+	}
+
+	switch {
+	case from.Kind() == reflect.Struct && from.Type() != timeType:
+		forEachField(from.Type(), func(i int, name string) {
+			diffValue(joinPath(path, name), from.Field(i), to.Field(i), changes)
+		})
+	case from.Kind() == reflect.Slice && isComposite(from.Type().Elem()):
+		fromItems := keyedItems(from)
+		toItems := keyedItems(to)
+		toIndex := make(map[string]reflect.Value, len(toItems))
+		for _, item := range toItems {
+			toIndex[item.key] = item.value
+		}
+		fromIndex := make(map[string]bool, len(fromItems))
+		for _, item := range fromItems {
+			fromIndex[item.key] = true
+			diffValue(path+item.key, item.value, toIndex[item.key], changes)
+		}
+		for _, item := range toItems {
+			if !fromIndex[item.key] {
+				diffValue(path+item.key, reflect.Value{}, item.value, changes)
+			}
+		}
+	case !equal(from, to):
+		*changes = append(*changes, Change{Path: path, Type: ChangeModified, From: from.Interface(), To: to.Interface()})
+	}
+}
+
+// keyedItem defines a list item with the path segment identifying the item.
+type keyedItem struct {
+	key   string
+	value reflect.Value
+}
+
+// keyedItems returns the items of a slice with a path segment identifying each item. Properties are also
+// identified by value when the remarks are empty or the name and remarks are not unique in the slice. Duplicate
+// keys are made unique with the occurrence number.
+func keyedItems(slice reflect.Value) []keyedItem {
+	keys := make([]string, slice.Len())
+	counts := make(map[string]int)
+	for i := range keys {
+		if key, ok := itemKey(slice.Index(i)); ok {
+			keys[i] = key
+			counts[key]++
+		}
+	}
+
+	items := make([]keyedItem, 0, slice.Len())
+	seen := make(map[string]int)
+	for i, key := range keys {
+		item := slice.Index(i)
+		if key == "" {
+			items = append(items, keyedItem{key: fmt.Sprintf("[%d]", i), value: item})
+			continue
+		}
+		if prop, ok := item.Interface().(oscalTypes.Property); ok && (prop.Remarks == "" || counts[key] > 1) {
+			key = predicate("name", prop.Name, "remarks", prop.Remarks, "value", prop.Value)
+		}
+		if count := seen[key]; count > 0 {
+			seen[key]++
+			key = fmt.Sprintf("%s#%d", key, count)
+		} else {
+			seen[key] = 1
+		}
+		items = append(items, keyedItem{key: "[" + key + "]", value: item})
+	}
+	return items
+}
+
+// itemKey returns the predicate identifying a list item.
+func itemKey(item reflect.Value) (string, bool) {
+	item, ok := indirect(item)
+	if !ok || item.Kind() != reflect.Struct {
+		return "", false
+	}
+	fields := make(map[string]string)
+	var uuidField string
+	forEachField(item.Type(), func(i int, name string) {
+		if item.Field(i).Kind() != reflect.String {
+			return
+		}
+		fields[name] = item.Field(i).String()
+		if uuidField == "" && strings.HasSuffix(name, "-uuid") && fields[name] != "" {
+			uuidField = name
+		}
+	})
+
+	if item.Type() == propertyType {
+		return predicate("name", fields["name"], "remarks", fields["remarks"]), true
+	}
+	if value := fields["uuid"]; value != "" {
+		return predicate("uuid", value), true
+	}
+	if uuidField != "" {
+		return predicate(uuidField, fields[uuidField]), true
+	}
+	for _, field := range keyFields[1:] {
+		if value := fields[field]; value != "" {
+			return predicate(field, value), true
+		}
+	}
+	if href, ok := fields["href"]; ok {
+		return predicate("href", href, "rel", fields["rel"]), true
+	}
+	return "", false
+}
+
+// predicate returns a list item predicate from pairs of names and values. Pairs
+// with an empty value after the first are omitted.
+func predicate(pairs ...string) string {
+	var terms []string
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if i > 0 && pairs[i+1] == "" {
+			continue
+		}
+		value := pairs[i+1]
+		if strings.ContainsAny(value, `,=[]"`) {
+			value = strconv.Quote(value)
+		}
+		terms = append(terms, pairs[i]+"="+value)
+	}
+	return strings.Join(terms, ",")
+}
+
+// forEachField calls fn for each field of a struct type with a JSON name.
+func forEachField(t reflect.Type, fn func(i int, name string)) {
+	for i := 0; i < t.NumField(); i++ {
+		if name := jsonName(t.Field(i)); name != "" {
+			fn(i, name)
+		}
+	}
+}
+
+// jsonName returns the JSON name for a struct field or an empty string if the
+// field is not serialized.
+func jsonName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// indirect dereferences pointers and interfaces and returns whether the value is set.
+func indirect(val reflect.Value) (reflect.Value, bool) {
+	for val.IsValid() && (val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface) {
+		if val.IsNil() {
+			return reflect.Value{}, false
+		}
+		val = val.Elem()
+	}
+	return val, val.IsValid()
+}
+
+// present dereferences a value and returns whether the value is set. Empty slices
+// and maps are not set, consistent with the omitempty JSON encoding of OSCAL models.
+func present(val reflect.Value) (reflect.Value, bool) {
+	val, ok := indirect(val)
+	if ok && (val.Kind() == reflect.Slice || val.Kind() == reflect.Map) && val.Len() == 0 {
+		return reflect.Value{}, false
+	}
+	return val, ok
+}
+
+// isComposite returns whether a list item type is compared by item.
+func isComposite(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && t != timeType
+}
+
+func equal(a, b reflect.Value) bool {
+	if a.Type() == timeType && b.Type() == timeType {
+		return a.Interface().(time.Time).Equal(b.Interface().(time.Time))
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
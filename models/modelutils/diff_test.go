@@ -0,0 +1,161 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package modelutils
+
+import (
+	"testing"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(model *oscalTypes.OscalModels)
+		wantChanges []Change
+	}{
+		{
+			name:   "Valid/NoChanges",
+			modify: func(_ *oscalTypes.OscalModels) {},
+		},
+		{
+			name: "Valid/Modified",
+			modify: func(model *oscalTypes.OscalModels) {
+				(*model.ComponentDefinition.Components)[1].Title = "Updated"
+			},
+			wantChanges: []Change{
+				{
+					Path: "component-definition.components[uuid=22222222-2222-4222-8222-222222222222].title",
+					Type: ChangeModified,
+					From: "Validator",
+					To:   "Updated",
+				},
+			},
+		},
+		{
+			name: "Valid/ReorderedItems",
+			modify: func(model *oscalTypes.OscalModels) {
+				components := *model.ComponentDefinition.Components
+				components[0], components[1] = components[1], components[0]
+			},
+		},
+		{
+			name: "Valid/AddedAndRemovedItems",
+			modify: func(model *oscalTypes.OscalModels) {
+				requirement := &(*(*model.ComponentDefinition.Components)[0].ControlImplementations)[0].ImplementedRequirements[0]
+				*requirement.Props = (*requirement.Props)[:1]
+				model.ComponentDefinition.Metadata.Remarks = "New remarks"
+			},
+			wantChanges: []Change{
+				{
+					Path: "component-definition.components[uuid=11111111-1111-4111-8111-111111111111].control-implementations[uuid=33333333-3333-4333-8333-333333333333]" +
+						".implemented-requirements[uuid=44444444-4444-4444-8444-444444444444].props[name=Rule_Id,value=rule-2]",
+					Type: ChangeRemoved,
+					From: oscalTypes.Property{Name: "Rule_Id", Value: "rule-2"},
+				},
+				{
+					Path: "component-definition.metadata.remarks",
+					Type: ChangeModified,
+					From: "",
+					To:   "New remarks",
+				},
+			},
+		},
+		{
+			name: "Valid/RemovedFirstPropWithoutRemarks",
+			modify: func(model *oscalTypes.OscalModels) {
+				requirement := &(*(*model.ComponentDefinition.Components)[0].ControlImplementations)[0].ImplementedRequirements[0]
+				*requirement.Props = (*requirement.Props)[1:]
+			},
+			wantChanges: []Change{
+				{
+					Path: "component-definition.components[uuid=11111111-1111-4111-8111-111111111111].control-implementations[uuid=33333333-3333-4333-8333-333333333333]" +
+						".implemented-requirements[uuid=44444444-4444-4444-8444-444444444444].props[name=Rule_Id,value=rule-1]",
+					Type: ChangeRemoved,
+					From: oscalTypes.Property{Name: "Rule_Id", Value: "rule-1"},
+				},
+			},
+		},
+		{
+			name: "Valid/PropertyByRemarks",
+			modify: func(model *oscalTypes.OscalModels) {
+				(*(*model.ComponentDefinition.Components)[0].Props)[1].Value = "Updated description"
+			},
+			wantChanges: []Change{
+				{
+					Path: "component-definition.components[uuid=11111111-1111-4111-8111-111111111111].props[name=Rule_Description,remarks=rule_set_00].value",
+					Type: ChangeModified,
+					From: "Rule 1",
+					To:   "Updated description",
+				},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			from := testModel()
+			to := testModel()
+			c.modify(&to)
+			require.Equal(t, c.wantChanges, Diff(&from, &to))
+		})
+	}
+}
+
+func testModel() oscalTypes.OscalModels {
+	return oscalTypes.OscalModels{
+		ComponentDefinition: &oscalTypes.ComponentDefinition{
+			UUID: "00000000-0000-4000-8000-000000000000",
+			Metadata: oscalTypes.Metadata{
+				Title:        "Component Definition",
+				OscalVersion: "1.1.3",
+				Version:      "0.1.0",
+				LastModified: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			Components: &[]oscalTypes.DefinedComponent{
+				{
+					UUID:        "11111111-1111-4111-8111-111111111111",
+					Title:       "Service",
+					Type:        "service",
+					Description: "A service",
+					Props: &[]oscalTypes.Property{
+						{Name: "Rule_Id", Value: "rule-1", Remarks: "rule_set_00"},
+						{Name: "Rule_Description", Value: "Rule 1", Remarks: "rule_set_00"},
+						{Name: "Rule_Id", Value: "rule-2", Remarks: "rule_set_01"},
+					},
+					ControlImplementations: &[]oscalTypes.ControlImplementationSet{
+						{
+							UUID:        "33333333-3333-4333-8333-333333333333",
+							Source:      "profile.json",
+							Description: "Profile",
+							SetParameters: &[]oscalTypes.SetParameter{
+								{ParamId: "param-1", Values: []string{"value-1"}},
+							},
+							ImplementedRequirements: []oscalTypes.ImplementedRequirementControlImplementation{
+								{
+									UUID:      "44444444-4444-4444-8444-444444444444",
+									ControlId: "ac-1",
+									Props: &[]oscalTypes.Property{
+										{Name: "Rule_Id", Value: "rule-1"},
+										{Name: "Rule_Id", Value: "rule-2"},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					UUID:        "22222222-2222-4222-8222-222222222222",
+					Title:       "Validator",
+					Type:        "validation",
+					Description: "A validator",
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,173 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package modelutils
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+)
+
+// Conflict defines a value changed differently in both versions of a three-way merge.
+type Conflict struct {
+	// Path is the location of the value using the same format as Change.
+	Path string `json:"path"`
+	// Base is the common ancestor value. It is nil when the value was added in both versions.
+	Base any `json:"base,omitempty"`
+	// Ours is the value in our version. It is nil when the value was removed.
+	Ours any `json:"ours,omitempty"`
+	// Theirs is the value in their version. It is nil when the value was removed.
+	Theirs any `json:"theirs,omitempty"`
+}
+
+// Merge performs a three-way merge of two versions of an OSCAL model with a common ancestor.
+//
+// Changes made in only one version are applied. Values changed in both versions are merged field by field and list
+// items are matched using the same identifying keys as Diff, so changes to different items or fields do not conflict.
+// A value changed differently in both versions, or modified in one version and removed in the other, is reported as a
+// Conflict and the value from ours is kept in the merged model.
+func Merge(base, ours, theirs *oscalTypes.OscalModels) (*oscalTypes.OscalModels, []Conflict, error) {
+	var conflicts []Conflict
+	modelType := reflect.TypeOf(base)
+	merged := merge3("", modelType, reflect.ValueOf(base), reflect.ValueOf(ours), reflect.ValueOf(theirs), &conflicts)
+	if merged.IsNil() {
+		return nil, conflicts, nil
+	}
+
+	// Copy the merged model so it does not share values with the inputs
+	data, err := json.Marshal(merged.Interface())
+	if err != nil {
+		return nil, conflicts, fmt.Errorf("failed to copy merged model: %w", err)
+	}
+	var model oscalTypes.OscalModels
+	if err := json.Unmarshal(data, &model); err != nil {
+		return nil, conflicts, fmt.Errorf("failed to copy merged model: %w", err)
+	}
+	return &model, conflicts, nil
+}
+
+// merge3 returns the merged value of type typ.
+func merge3(path string, typ reflect.Type, base, ours, theirs reflect.Value, conflicts *[]Conflict) reflect.Value {
+	base, ours, theirs = orZero(typ, base), orZero(typ, ours), orZero(typ, theirs)
+	switch {
+	case same(ours, theirs):
+		return ours
+	case same(base, ours):
+		return theirs
+	case same(base, theirs):
+		return ours
+	}
+
+	b, bOk := present(base)
+	o, oOk := present(ours)
+	t, tOk := present(theirs)
+	if oOk && tOk {
+		switch {
+		case o.Kind() == reflect.Struct && o.Type() != timeType:
+			merged := reflect.New(o.Type()).Elem()
+			merged.Set(o)
+			forEachField(o.Type(), func(i int, name string) {
+				var baseField reflect.Value
+				if bOk {
+					baseField = b.Field(i)
+				}
+				fieldType := o.Type().Field(i).Type
+				merged.Field(i).Set(merge3(joinPath(path, name), fieldType, baseField, o.Field(i), t.Field(i), conflicts))
+			})
+			return wrap(typ, merged)
+		case o.Kind() == reflect.Slice && isComposite(o.Type().Elem()):
+			return wrap(typ, mergeSlice(path, o.Type(), b, o, t, conflicts))
+		}
+	}
+
+	*conflicts = append(*conflicts, Conflict{Path: path, Base: iface(b), Ours: iface(o), Theirs: iface(t)})
+	return ours
+}
+
+// mergeSlice merges list items matched by identifying keys. Items are kept in the order
+// of ours followed by the items added in theirs.
+func mergeSlice(path string, sliceType reflect.Type, base, ours, theirs reflect.Value, conflicts *[]Conflict) reflect.Value {
+	index := func(slice reflect.Value) ([]keyedItem, map[string]reflect.Value) {
+		byKey := make(map[string]reflect.Value)
+		if !slice.IsValid() {
+			return nil, byKey
+		}
+		items := keyedItems(slice)
+		for _, item := range items {
+			byKey[item.key] = item.value
+		}
+		return items, byKey
+	}
+	_, baseIndex := index(base)
+	ourItems, ourIndex := index(ours)
+	theirItems, theirIndex := index(theirs)
+
+	elemType := sliceType.Elem()
+	merged := reflect.MakeSlice(sliceType, 0, len(ourItems))
+	for _, item := range ourItems {
+		baseItem, inBase := baseIndex[item.key]
+		theirItem, inTheirs := theirIndex[item.key]
+		switch {
+		case inTheirs:
+			merged = reflect.Append(merged, merge3(path+item.key, elemType, baseItem, item.value, theirItem, conflicts))
+		case !inBase:
+			merged = reflect.Append(merged, item.value)
+		case !same(baseItem, item.value):
+			*conflicts = append(*conflicts, Conflict{Path: path + item.key, Base: iface(baseItem), Ours: iface(item.value)})
+			merged = reflect.Append(merged, item.value)
+		}
+	}
+	for _, item := range theirItems {
+		if _, inOurs := ourIndex[item.key]; inOurs {
+			continue
+		}
+		baseItem, inBase := baseIndex[item.key]
+		switch {
+		case !inBase:
+			merged = reflect.Append(merged, item.value)
+		case !same(baseItem, item.value):
+			*conflicts = append(*conflicts, Conflict{Path: path + item.key, Base: iface(baseItem), Theirs: iface(item.value)})
+		}
+	}
+	return merged
+}
+
+// same returns whether two values are equal, with unset values equal to each other.
+func same(a, b reflect.Value) bool {
+	a, aOk := present(a)
+	b, bOk := present(b)
+	if !aOk || !bOk {
+		return aOk == bOk
+	}
+	return equal(a, b)
+}
+
+// wrap returns a value of type typ for a dereferenced value.
+func wrap(typ reflect.Type, val reflect.Value) reflect.Value {
+	if typ == val.Type() {
+		return val
+	}
+	ptr := reflect.New(typ.Elem())
+	ptr.Elem().Set(wrap(typ.Elem(), val))
+	return ptr
+}
+
+func orZero(typ reflect.Type, val reflect.Value) reflect.Value {
+	if !val.IsValid() {
+		return reflect.Zero(typ)
+	}
+	return val
+}
+
+func iface(val reflect.Value) any {
+	val, ok := present(val)
+	if !ok {
+		return nil
+	}
+	return val.Interface()
+}
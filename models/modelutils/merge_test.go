@@ -0,0 +1,142 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package modelutils
+
+import (
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name          string
+		ours          func(model *oscalTypes.OscalModels)
+		theirs        func(model *oscalTypes.OscalModels)
+		wantModel     func(model *oscalTypes.OscalModels)
+		wantConflicts []Conflict
+	}{
+		{
+			name: "Valid/DifferentFields",
+			ours: func(model *oscalTypes.OscalModels) {
+				(*model.ComponentDefinition.Components)[0].Title = "Our Service"
+			},
+			theirs: func(model *oscalTypes.OscalModels) {
+				(*model.ComponentDefinition.Components)[0].Description = "Their description"
+			},
+			wantModel: func(model *oscalTypes.OscalModels) {
+				(*model.ComponentDefinition.Components)[0].Title = "Our Service"
+				(*model.ComponentDefinition.Components)[0].Description = "Their description"
+			},
+		},
+		{
+			name: "Valid/AddedItems",
+			ours: func(model *oscalTypes.OscalModels) {
+				props := (*model.ComponentDefinition.Components)[0].Props
+				*props = append(*props, oscalTypes.Property{Name: "Rule_Id", Value: "rule-3", Remarks: "rule_set_02"})
+			},
+			theirs: func(model *oscalTypes.OscalModels) {
+				props := (*model.ComponentDefinition.Components)[0].Props
+				*props = append(*props, oscalTypes.Property{Name: "Rule_Id", Value: "rule-4", Remarks: "rule_set_03"})
+				*model.ComponentDefinition.Components = (*model.ComponentDefinition.Components)[:1]
+			},
+			wantModel: func(model *oscalTypes.OscalModels) {
+				props := (*model.ComponentDefinition.Components)[0].Props
+				*props = append(*props,
+					oscalTypes.Property{Name: "Rule_Id", Value: "rule-3", Remarks: "rule_set_02"},
+					oscalTypes.Property{Name: "Rule_Id", Value: "rule-4", Remarks: "rule_set_03"},
+				)
+				*model.ComponentDefinition.Components = (*model.ComponentDefinition.Components)[:1]
+			},
+		},
+		{
+			name: "Valid/SameChange",
+			ours: func(model *oscalTypes.OscalModels) {
+				model.ComponentDefinition.Metadata.Title = "Updated"
+			},
+			theirs: func(model *oscalTypes.OscalModels) {
+				model.ComponentDefinition.Metadata.Title = "Updated"
+			},
+			wantModel: func(model *oscalTypes.OscalModels) {
+				model.ComponentDefinition.Metadata.Title = "Updated"
+			},
+		},
+		{
+			name: "Valid/RemovedPropsWithoutRemarks",
+			ours: func(model *oscalTypes.OscalModels) {
+				requirement := &(*(*model.ComponentDefinition.Components)[0].ControlImplementations)[0].ImplementedRequirements[0]
+				*requirement.Props = (*requirement.Props)[1:]
+			},
+			theirs: func(model *oscalTypes.OscalModels) {
+				requirement := &(*(*model.ComponentDefinition.Components)[0].ControlImplementations)[0].ImplementedRequirements[0]
+				*requirement.Props = (*requirement.Props)[:1]
+			},
+			wantModel: func(model *oscalTypes.OscalModels) {
+				(*(*model.ComponentDefinition.Components)[0].ControlImplementations)[0].ImplementedRequirements[0].Props = nil
+			},
+		},
+		{
+			name: "Invalid/Conflicts",
+			ours: func(model *oscalTypes.OscalModels) {
+				model.ComponentDefinition.Metadata.Title = "Ours"
+				(*model.ComponentDefinition.Components)[1].Title = "Our Validator"
+			},
+			theirs: func(model *oscalTypes.OscalModels) {
+				model.ComponentDefinition.Metadata.Title = "Theirs"
+				*model.ComponentDefinition.Components = (*model.ComponentDefinition.Components)[:1]
+			},
+			wantModel: func(model *oscalTypes.OscalModels) {
+				model.ComponentDefinition.Metadata.Title = "Ours"
+				(*model.ComponentDefinition.Components)[1].Title = "Our Validator"
+			},
+			wantConflicts: []Conflict{
+				{
+					Path: "component-definition.components[uuid=22222222-2222-4222-8222-222222222222]",
+					Base: oscalTypes.DefinedComponent{
+						UUID:        "22222222-2222-4222-8222-222222222222",
+						Title:       "Validator",
+						Type:        "validation",
+						Description: "A validator",
+					},
+					Ours: oscalTypes.DefinedComponent{
+						UUID:        "22222222-2222-4222-8222-222222222222",
+						Title:       "Our Validator",
+						Type:        "validation",
+						Description: "A validator",
+					},
+				},
+				{
+					Path:   "component-definition.metadata.title",
+					Base:   "Component Definition",
+					Ours:   "Ours",
+					Theirs: "Theirs",
+				},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			base := testModel()
+			ours := testModel()
+			theirs := testModel()
+			want := testModel()
+			c.ours(&ours)
+			c.theirs(&theirs)
+			c.wantModel(&want)
+
+			merged, conflicts, err := Merge(&base, &ours, &theirs)
+			require.NoError(t, err)
+			require.Equal(t, c.wantConflicts, conflicts)
+			require.Empty(t, Diff(&want, merged))
+
+			// The inputs are not modified by the merge
+			unchanged := testModel()
+			require.Empty(t, Diff(&unchanged, &base))
+		})
+	}
+}
@@ -0,0 +1,298 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package modelutils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+)
+
+var (
+	// ErrInvalidQuery defines an error returned when a query expression cannot be parsed.
+	ErrInvalidQuery = errors.New("invalid query")
+	// ErrTypeMismatch defines an error returned when a value does not have the type of the matched value.
+	ErrTypeMismatch = errors.New("value type does not match")
+)
+
+// Query defines a path expression over the JSON names of an OSCAL model.
+//
+// A query is a list of JSON names separated by "." starting from the model name, for example
+// `component-definition.components.title`. Lists are traversed implicitly and a name may be followed by a
+// predicate in brackets to select list items: `[*]` selects all items, `[n]` selects the item at an index and
+// `[name=value,...]` selects the items where all the named string fields are equal to the values. Values with
+// special characters can be quoted and a "#n" suffix selects only the nth matching item, counting from zero.
+// The paths returned by Diff are valid queries.
+type Query struct {
+	expr     string
+	segments []segment
+}
+
+type segment struct {
+	name      string
+	predicate *predicateExpr
+}
+
+type predicateExpr struct {
+	all        bool
+	index      int
+	fields     [][2]string
+	occurrence int
+}
+
+// Match defines a value matched by a Query.
+type Match struct {
+	// Path is the location of the value using the same format as Change.
+	Path string
+	// Value is the matched value.
+	Value any
+
+	value reflect.Value
+}
+
+// ParseQuery returns a Query for a path expression.
+func ParseQuery(expr string) (Query, error) {
+	query := Query{expr: expr}
+	parts, err := splitOutside(expr, '.')
+	if err != nil {
+		return query, err
+	}
+	for _, part := range parts {
+		name, rest, hasPredicate := strings.Cut(part, "[")
+		if name == "" {
+			return query, fmt.Errorf("%w %q: empty name", ErrInvalidQuery, expr)
+		}
+		seg := segment{name: name}
+		if hasPredicate {
+			if !strings.HasSuffix(rest, "]") {
+				return query, fmt.Errorf("%w %q: unterminated predicate", ErrInvalidQuery, expr)
+			}
+			predicate, err := parsePredicate(strings.TrimSuffix(rest, "]"))
+			if err != nil {
+				return query, fmt.Errorf("%w %q: %v", ErrInvalidQuery, expr, err)
+			}
+			seg.predicate = predicate
+		}
+		query.segments = append(query.segments, seg)
+	}
+	return query, nil
+}
+
+// String returns the query expression.
+func (q Query) String() string {
+	return q.expr
+}
+
+// Find returns the values in a model matching the Query. Matched values can be
+// updated with Match.Set.
+func (q Query) Find(model *oscalTypes.OscalModels) []Match {
+	var matches []Match
+	q.walk(reflect.ValueOf(model), "", q.segments, &matches)
+	return matches
+}
+
+// Find returns the values in a model matching a query expression.
+func Find(model *oscalTypes.OscalModels, expr string) ([]Match, error) {
+	query, err := ParseQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+	return query.Find(model), nil
+}
+
+// FindValues returns the values of type T in a model matching a query expression.
+func FindValues[T any](model *oscalTypes.OscalModels, expr string) ([]T, error) {
+	matches, err := Find(model, expr)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]T, 0, len(matches))
+	for _, match := range matches {
+		value, ok := match.Value.(T)
+		if !ok {
+			return nil, fmt.Errorf("%w at %s: %T", ErrTypeMismatch, match.Path, match.Value)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// Set replaces the matched value in the model. The value must have the type of the matched value.
+func (m *Match) Set(value any) error {
+	newValue := reflect.ValueOf(value)
+	if !newValue.IsValid() || !newValue.Type().AssignableTo(m.value.Type()) {
+		return fmt.Errorf("%w at %s: %T is not %s", ErrTypeMismatch, m.Path, value, m.value.Type())
+	}
+	m.value.Set(newValue)
+	m.Value = value
+	return nil
+}
+
+func (q Query) walk(val reflect.Value, path string, segments []segment, matches *[]Match) {
+	val, ok := indirect(val)
+	if !ok {
+		return
+	}
+	if len(segments) == 0 {
+		*matches = append(*matches, Match{Path: path, Value: val.Interface(), value: val})
+		return
+	}
+	if val.Kind() == reflect.Slice {
+		for _, item := range keyedItems(val) {
+			q.walk(item.value, path+item.key, segments, matches)
+		}
+		return
+	}
+	if val.Kind() != reflect.Struct {
+		return
+	}
+
+	seg := segments[0]
+	field, ok := fieldByJSONName(val, seg.name)
+	if !ok {
+		return
+	}
+	path = joinPath(path, seg.name)
+	if seg.predicate == nil {
+		q.walk(field, path, segments[1:], matches)
+		return
+	}
+	field, ok = indirect(field)
+	if !ok {
+		return
+	}
+	if field.Kind() != reflect.Slice {
+		if seg.predicate.matches(-1, field) {
+			q.walk(field, path, segments[1:], matches)
+		}
+		return
+	}
+	occurrence := 0
+	for i, item := range keyedItems(field) {
+		if !seg.predicate.matches(i, item.value) {
+			continue
+		}
+		if seg.predicate.occurrence < 0 || seg.predicate.occurrence == occurrence {
+			q.walk(item.value, path+item.key, segments[1:], matches)
+		}
+		occurrence++
+	}
+}
+
+// matches returns whether a value at an index satisfies the predicate.
+func (p *predicateExpr) matches(index int, val reflect.Value) bool {
+	if p.all {
+		return true
+	}
+	if p.fields == nil {
+		return index == p.index
+	}
+	val, ok := indirect(val)
+	if !ok || val.Kind() != reflect.Struct {
+		return false
+	}
+	for _, field := range p.fields {
+		fieldVal, ok := fieldByJSONName(val, field[0])
+		if !ok || fieldVal.Kind() != reflect.String || fieldVal.String() != field[1] {
+			return false
+		}
+	}
+	return true
+}
+
+func parsePredicate(expr string) (*predicateExpr, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "*" {
+		return &predicateExpr{all: true, occurrence: -1}, nil
+	}
+	if index, err := strconv.Atoi(expr); err == nil {
+		return &predicateExpr{index: index, occurrence: -1}, nil
+	}
+	terms, err := splitOutside(expr, ',')
+	if err != nil {
+		return nil, err
+	}
+	predicate := &predicateExpr{occurrence: -1}
+	for i, term := range terms {
+		name, value, found := strings.Cut(term, "=")
+		if !found || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid predicate term %q", term)
+		}
+		value = strings.TrimSpace(value)
+		if i == len(terms)-1 {
+			value, predicate.occurrence = cutOccurrence(value)
+		}
+		if strings.HasPrefix(value, `"`) {
+			if value, err = strconv.Unquote(value); err != nil {
+				return nil, fmt.Errorf("invalid quoted value in %q", term)
+			}
+		}
+		predicate.fields = append(predicate.fields, [2]string{strings.TrimSpace(name), value})
+	}
+	return predicate, nil
+}
+
+// cutOccurrence returns a predicate value without the "#n" suffix used by Diff for
+// duplicate keys and the occurrence number, or -1 if there is no suffix.
+func cutOccurrence(value string) (string, int) {
+	hash := strings.LastIndex(value, "#")
+	if hash < 0 || (strings.HasPrefix(value, `"`) && !strings.HasSuffix(value[:hash], `"`)) {
+		return value, -1
+	}
+	occurrence, err := strconv.Atoi(value[hash+1:])
+	if err != nil {
+		return value, -1
+	}
+	return value[:hash], occurrence
+}
+
+// splitOutside splits an expression on a separator outside of brackets and quotes.
+func splitOutside(expr string, separator rune) ([]string, error) {
+	var parts []string
+	var current strings.Builder
+	depth := 0
+	quoted := false
+	escaped := false
+	for _, r := range expr {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case quoted:
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+		case r == separator && depth == 0:
+			parts = append(parts, current.String())
+			current.Reset()
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if quoted || depth != 0 {
+		return nil, fmt.Errorf("%w %q: unbalanced brackets or quotes", ErrInvalidQuery, expr)
+	}
+	return append(parts, current.String()), nil
+}
+
+// fieldByJSONName returns the struct field with a JSON name.
+func fieldByJSONName(val reflect.Value, name string) (reflect.Value, bool) {
+	var field reflect.Value
+	forEachField(val.Type(), func(i int, fieldName string) {
+		if fieldName == name && !field.IsValid() {
+			field = val.Field(i)
+		}
+	})
+	return field, field.IsValid()
+}
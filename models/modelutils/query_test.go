@@ -0,0 +1,146 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package modelutils
+
+import (
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name       string
+		expr       string
+		wantPaths  []string
+		wantValues []any
+		wantErr    error
+	}{
+		{
+			name:       "Valid/Field",
+			expr:       "component-definition.metadata.title",
+			wantPaths:  []string{"component-definition.metadata.title"},
+			wantValues: []any{"Component Definition"},
+		},
+		{
+			name: "Valid/ImplicitList",
+			expr: "component-definition.components.title",
+			wantPaths: []string{
+				"component-definition.components[uuid=11111111-1111-4111-8111-111111111111].title",
+				"component-definition.components[uuid=22222222-2222-4222-8222-222222222222].title",
+			},
+			wantValues: []any{"Service", "Validator"},
+		},
+		{
+			name: "Valid/FieldPredicate",
+			expr: "component-definition.components[*].control-implementations.implemented-requirements[control-id=ac-1].props[name=Rule_Id].value",
+			wantPaths: []string{
+				"component-definition.components[uuid=11111111-1111-4111-8111-111111111111].control-implementations[uuid=33333333-3333-4333-8333-333333333333]" +
+					".implemented-requirements[uuid=44444444-4444-4444-8444-444444444444].props[name=Rule_Id,value=rule-1].value",
+				"component-definition.components[uuid=11111111-1111-4111-8111-111111111111].control-implementations[uuid=33333333-3333-4333-8333-333333333333]" +
+					".implemented-requirements[uuid=44444444-4444-4444-8444-444444444444].props[name=Rule_Id,value=rule-2].value",
+			},
+			wantValues: []any{"rule-1", "rule-2"},
+		},
+		{
+			name: "Valid/AllItems",
+			expr: "component-definition.components[*].title",
+			wantPaths: []string{
+				"component-definition.components[uuid=11111111-1111-4111-8111-111111111111].title",
+				"component-definition.components[uuid=22222222-2222-4222-8222-222222222222].title",
+			},
+			wantValues: []any{"Service", "Validator"},
+		},
+		{
+			name:       "Valid/Index",
+			expr:       `component-definition.components[1].title`,
+			wantPaths:  []string{"component-definition.components[uuid=22222222-2222-4222-8222-222222222222].title"},
+			wantValues: []any{"Validator"},
+		},
+		{
+			name: "Valid/DiffPathWithOccurrence",
+			expr: "component-definition.components[uuid=11111111-1111-4111-8111-111111111111].control-implementations[uuid=33333333-3333-4333-8333-333333333333]" +
+				".implemented-requirements[uuid=44444444-4444-4444-8444-444444444444].props[name=Rule_Id#1].value",
+			wantPaths: []string{
+				"component-definition.components[uuid=11111111-1111-4111-8111-111111111111].control-implementations[uuid=33333333-3333-4333-8333-333333333333]" +
+					".implemented-requirements[uuid=44444444-4444-4444-8444-444444444444].props[name=Rule_Id,value=rule-2].value",
+			},
+			wantValues: []any{"rule-2"},
+		},
+		{
+			name:       "Valid/QuotedValue",
+			expr:       `component-definition.components.props[name="Rule_Id",remarks="rule_set_01"].value`,
+			wantPaths:  []string{"component-definition.components[uuid=11111111-1111-4111-8111-111111111111].props[name=Rule_Id,remarks=rule_set_01].value"},
+			wantValues: []any{"rule-2"},
+		},
+		{
+			name: "Valid/NoMatch",
+			expr: "component-definition.components[type=software].title",
+		},
+		{
+			name:    "Invalid/UnterminatedPredicate",
+			expr:    "component-definition.components[uuid=1",
+			wantErr: ErrInvalidQuery,
+		},
+		{
+			name:    "Invalid/EmptyName",
+			expr:    "component-definition..title",
+			wantErr: ErrInvalidQuery,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			model := testModel()
+			matches, err := Find(&model, c.expr)
+			if c.wantErr != nil {
+				require.ErrorIs(t, err, c.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			var paths []string
+			var values []any
+			for _, match := range matches {
+				paths = append(paths, match.Path)
+				values = append(values, match.Value)
+			}
+			require.Equal(t, c.wantPaths, paths)
+			require.Equal(t, c.wantValues, values)
+		})
+	}
+}
+
+func TestFindValues(t *testing.T) {
+	model := testModel()
+	setParams, err := FindValues[oscalTypes.SetParameter](&model, "component-definition.components.control-implementations.set-parameters[param-id=param-1]")
+	require.NoError(t, err)
+	require.Equal(t, []oscalTypes.SetParameter{{ParamId: "param-1", Values: []string{"value-1"}}}, setParams)
+
+	_, err = FindValues[int](&model, "component-definition.metadata.title")
+	require.ErrorIs(t, err, ErrTypeMismatch)
+}
+
+func TestMatch_Set(t *testing.T) {
+	model := testModel()
+	matches, err := Find(&model, "component-definition.components.props[name=Rule_Id].value")
+	require.NoError(t, err)
+	require.Len(t, matches, 2)
+	for i := range matches {
+		require.NoError(t, matches[i].Set("updated-"+matches[i].Value.(string)))
+	}
+	require.Equal(t, "updated-rule-1", (*(*model.ComponentDefinition.Components)[0].Props)[0].Value)
+	require.Equal(t, "updated-rule-2", (*(*model.ComponentDefinition.Components)[0].Props)[2].Value)
+
+	matches, err = Find(&model, "component-definition.components[uuid=22222222-2222-4222-8222-222222222222]")
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.ErrorIs(t, matches[0].Set("invalid"), ErrTypeMismatch)
+	component := matches[0].Value.(oscalTypes.DefinedComponent)
+	component.Title = "Replaced"
+	require.NoError(t, matches[0].Set(component))
+	require.Equal(t, "Replaced", (*model.ComponentDefinition.Components)[1].Title)
+}
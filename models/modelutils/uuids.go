@@ -0,0 +1,108 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package modelutils
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+)
+
+// uuidListFields are the JSON names of string lists containing UUID references.
+var uuidListFields = map[string]bool{
+	"member-of-organizations": true,
+}
+
+type regenerateOpts struct {
+	seed          string
+	deterministic bool
+}
+
+// RegenerateOption defines an option to tune the behavior of the
+// RegenerateUUIDs function.
+type RegenerateOption func(opts *regenerateOpts)
+
+// WithSeed is a RegenerateOption that derives each new UUID from the seed and the
+// original UUID, so regenerating the same model with the same seed returns the same UUIDs.
+func WithSeed(seed string) RegenerateOption {
+	return func(opts *regenerateOpts) {
+		opts.seed = seed
+		opts.deterministic = true
+	}
+}
+
+// RegenerateUUIDs replaces every UUID defined in a model with a new UUID and rewrites all internal references.
+// A mapping of the original to the new UUIDs is returned.
+//
+// UUIDs are defined by fields named uuid. References are rewritten in fields ending in -uuid, lists of UUIDs
+// such as party-uuids, and hrefs to fragments in the form `#uuid`. References to UUIDs not defined in the model are
+// left unchanged. New UUIDs are random unless `WithSeed` is set.
+func RegenerateUUIDs(model *oscalTypes.OscalModels, opts ...RegenerateOption) map[string]string {
+	options := regenerateOpts{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	mapping := make(map[string]string)
+	walkStrings(reflect.ValueOf(model), "", func(name string, val reflect.Value) {
+		if name != "uuid" || val.String() == "" {
+			return
+		}
+		if _, ok := mapping[val.String()]; ok {
+			return
+		}
+		if options.deterministic {
+			mapping[val.String()] = uuid.NewUUIDWithSource(options.seed + "/" + val.String())
+		} else {
+			mapping[val.String()] = uuid.NewUUID()
+		}
+	})
+
+	walkStrings(reflect.ValueOf(model), "", func(name string, val reflect.Value) {
+		value := val.String()
+		switch {
+		case name == "uuid" || strings.HasSuffix(name, "-uuid") || strings.HasSuffix(name, "-uuids") || uuidListFields[name]:
+			if replacement, ok := mapping[value]; ok {
+				val.SetString(replacement)
+			}
+		case name == "href" && strings.HasPrefix(value, "#"):
+			if replacement, ok := mapping[strings.TrimPrefix(value, "#")]; ok {
+				val.SetString("#" + replacement)
+			}
+		}
+	})
+	return mapping
+}
+
+// walkStrings calls fn for every settable string value in a model with the JSON name of the
+// field. Strings in lists are passed with the name of the list field.
+func walkStrings(val reflect.Value, name string, fn func(name string, val reflect.Value)) {
+	val, ok := indirect(val)
+	if !ok {
+		return
+	}
+	switch val.Kind() {
+	case reflect.String:
+		if val.CanSet() {
+			fn(name, val)
+		}
+	case reflect.Struct:
+		if val.Type() == timeType {
+			return
+		}
+		forEachField(val.Type(), func(i int, fieldName string) {
+			walkStrings(val.Field(i), fieldName, fn)
+		})
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			walkStrings(val.Index(i), name, fn)
+		}
+	default:
+		// not object-like, do nothing
+	}
+}
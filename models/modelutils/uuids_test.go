@@ -0,0 +1,95 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package modelutils
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegenerateUUIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []RegenerateOption
+	}{
+		{
+			name: "Valid/Random",
+		},
+		{
+			name:    "Valid/WithSeed",
+			options: []RegenerateOption{WithSeed("product-a")},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			original := testAssessmentPlan(t)
+			model := testAssessmentPlan(t)
+			model.AssessmentPlan.BackMatter = &oscalTypes.BackMatter{
+				Resources: &[]oscalTypes.Resource{{UUID: "8a4b0a4c-5a0d-4e0b-9d4c-2e1b7a1d0c01"}},
+			}
+			model.AssessmentPlan.ImportSsp.Href = "#8a4b0a4c-5a0d-4e0b-9d4c-2e1b7a1d0c01"
+
+			mapping := RegenerateUUIDs(&model, c.options...)
+			require.NotEmpty(t, mapping)
+
+			// All defined UUIDs are replaced
+			for _, value := range FindValuesByName(&model, "UUID") {
+				_, isOriginal := mapping[value]
+				require.False(t, isOriginal, "UUID %q was not replaced", value)
+			}
+			require.Len(t, mapping, len(uniqueValues(FindValuesByName(&model, "UUID"))))
+
+			// References are rewritten consistently
+			plan := model.AssessmentPlan
+			activities := make(map[string]bool)
+			for _, activity := range *plan.LocalDefinitions.Activities {
+				activities[activity.UUID] = true
+			}
+			for i, task := range *plan.Tasks {
+				originalTask := (*original.AssessmentPlan.Tasks)[i]
+				require.Equal(t, mapping[originalTask.UUID], task.UUID)
+				for _, associated := range *task.AssociatedActivities {
+					require.True(t, activities[associated.ActivityUuid])
+				}
+			}
+			require.Equal(t, "#"+mapping["8a4b0a4c-5a0d-4e0b-9d4c-2e1b7a1d0c01"], plan.ImportSsp.Href)
+
+			// Other values are not changed
+			require.Equal(t, original.AssessmentPlan.Metadata.Title, plan.Metadata.Title)
+
+			if len(c.options) > 0 {
+				again := testAssessmentPlan(t)
+				againMapping := RegenerateUUIDs(&again, c.options...)
+				for originalUUID, newUUID := range againMapping {
+					require.Equal(t, mapping[originalUUID], newUUID)
+				}
+			}
+		})
+	}
+}
+
+func testAssessmentPlan(t *testing.T) oscalTypes.OscalModels {
+	data, err := os.ReadFile("../../testdata/test-ap.json")
+	require.NoError(t, err)
+	var model oscalTypes.OscalModels
+	require.NoError(t, json.Unmarshal(data, &model))
+	return model
+}
+
+func uniqueValues(values []string) map[string]bool {
+	unique := make(map[string]bool)
+	for _, value := range values {
+		if value != "" {
+			unique[value] = true
+		}
+	}
+	return unique
+}
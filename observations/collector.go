@@ -0,0 +1,89 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package observations
+
+import (
+	"fmt"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+)
+
+// collector aggregates OSCAL Observations by check id.
+type collector struct {
+	source   string
+	checkIds []string
+	byCheck  map[string]*oscalTypes.Observation
+	uuid     models.UUIDFunc
+	clock    models.Clock
+}
+
+func newCollector(source string, options convertOpts) *collector {
+	return &collector{
+		source:  source,
+		byCheck: make(map[string]*oscalTypes.Observation),
+		uuid:    options.uuid,
+		clock:   options.clock,
+	}
+}
+
+// getOrCreate returns the existing observation for a check id or a newly created one.
+func (c *collector) getOrCreate(checkId string) *oscalTypes.Observation {
+	observation, ok := c.byCheck[checkId]
+	if !ok {
+		observation = &oscalTypes.Observation{
+			UUID:        c.uuid(fmt.Sprintf("observation/%s/%s", c.source, checkId)),
+			Title:       checkId,
+			Description: fmt.Sprintf("Observation of check %q from %s", checkId, c.source),
+			Methods:     []string{"TEST"},
+			Props: &[]oscalTypes.Property{
+				{
+					Name:  extensions.AssessmentCheckIdProp,
+					Value: checkId,
+					Ns:    extensions.TrestleNameSpace,
+				},
+			},
+		}
+		c.byCheck[checkId] = observation
+		c.checkIds = append(c.checkIds, checkId)
+	}
+	return observation
+}
+
+// addSubject adds a subject to the observation for a check id.
+func (c *collector) addSubject(checkId string, subject oscalTypes.SubjectReference) {
+	observation := c.getOrCreate(checkId)
+	if observation.Subjects == nil {
+		observation.Subjects = &[]oscalTypes.SubjectReference{}
+	}
+	*observation.Subjects = append(*observation.Subjects, subject)
+}
+
+// collected updates the collection time of the observation for a check id
+// if the given time is later.
+func (c *collector) collected(checkId string, collected time.Time) {
+	observation := c.getOrCreate(checkId)
+	if collected.After(observation.Collected) {
+		observation.Collected = collected
+	}
+}
+
+// observations returns all collected observations in the order the checks were found.
+func (c *collector) observations() []oscalTypes.Observation {
+	observations := make([]oscalTypes.Observation, 0, len(c.checkIds))
+	now := c.clock()
+	for _, checkId := range c.checkIds {
+		observation := *c.byCheck[checkId]
+		if observation.Collected.IsZero() {
+			observation.Collected = now
+		}
+		observations = append(observations, observation)
+	}
+	return observations
+}
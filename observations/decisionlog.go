@@ -0,0 +1,231 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package observations
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+)
+
+const decisionLogSource = "OPA decision log"
+
+// violationRules are the decision rules where a result reports violations
+// instead of allowed requests.
+var violationRules = []string{"deny", "violation", "violations"}
+
+// DecisionLog defines the fields used from an Open Policy Agent decision log entry.
+type DecisionLog struct {
+	DecisionID string          `json:"decision_id"`
+	Path       string          `json:"path"`
+	Result     json.RawMessage `json:"result,omitempty"`
+	Error      json.RawMessage `json:"error,omitempty"`
+	Timestamp  time.Time       `json:"timestamp"`
+}
+
+// ReadDecisionLogs reads OPA decision log entries from newline-delimited JSON or
+// a JSON array.
+func ReadDecisionLogs(reader io.Reader) ([]DecisionLog, error) {
+	bufReader := bufio.NewReader(reader)
+	decoder := json.NewDecoder(bufReader)
+	if isJSONArray(bufReader) {
+		var logs []DecisionLog
+		if err := decoder.Decode(&logs); err != nil {
+			return nil, fmt.Errorf("failed to decode decision logs: %w", err)
+		}
+		return logs, nil
+	}
+
+	var logs []DecisionLog
+	for {
+		var log DecisionLog
+		if err := decoder.Decode(&log); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode decision logs: %w", err)
+		}
+		logs = append(logs, log)
+	}
+	return logs, nil
+}
+
+// ObservationsFromDecisionLogs reads OPA decision logs and converts the decisions to OSCAL Observations.
+func ObservationsFromDecisionLogs(reader io.Reader, opts ...ConvertOption) ([]oscalTypes.Observation, error) {
+	logs, err := ReadDecisionLogs(reader)
+	if err != nil {
+		return nil, err
+	}
+	return DecisionLogsToObservations(logs, opts...), nil
+}
+
+// DecisionLogsToObservations converts OPA decisions to OSCAL Observations with one Observation per check.
+//
+// Policies are expected to be queried at a path ending with the check id and the decision rule, such as
+// "compliance/check_1/deny", so the second to last path segment is used as the check id. If `WithCheckIds` is set,
+// the first path segment that is a known check is used instead. Boolean decisions are recorded as a pass when true, except
+// for the "deny", "violation", and "violations" rules where true is recorded as a failure. Collections of violations are
+// recorded as a pass when empty with the violations as the reason. Decisions with an error are recorded
+// as an error and undefined decisions as not-applicable.
+func DecisionLogsToObservations(logs []DecisionLog, opts ...ConvertOption) []oscalTypes.Observation {
+	options := convertOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	observations := newCollector(decisionLogSource, options)
+	resultsByCheck := make(map[string][]extensions.Result)
+	reasonsByCheck := make(map[string][]string)
+	for _, log := range logs {
+		checkId, found := options.resolveCheckId(decisionCheckCandidates(log.Path)...)
+		if !found {
+			continue
+		}
+		observations.getOrCreate(checkId)
+		observations.collected(checkId, log.Timestamp)
+
+		result, reason := log.result()
+		resultsByCheck[checkId] = append(resultsByCheck[checkId], result)
+		if reason != "" {
+			reasonsByCheck[checkId] = append(reasonsByCheck[checkId], reason)
+		}
+	}
+
+	for checkId, checkResults := range resultsByCheck {
+		observation := observations.getOrCreate(checkId)
+		results.SetObservationResult(observation, results.AggregateResults(checkResults...), strings.Join(reasonsByCheck[checkId], "\n"))
+	}
+	return observations.observations()
+}
+
+// decisionCheckCandidates returns the possible check ids for a decision path with
+// the second to last segment first.
+func decisionCheckCandidates(decisionPath string) []string {
+	segments := strings.Split(strings.Trim(decisionPath, "/"), "/")
+	var candidates []string
+	if len(segments) > 1 {
+		candidates = append(candidates, segments[len(segments)-2])
+	}
+	for idx := len(segments) - 1; idx >= 0; idx-- {
+		candidates = append(candidates, segments[idx])
+	}
+	return candidates
+}
+
+// result returns the Result and reason for a decision.
+func (d DecisionLog) result() (extensions.Result, string) {
+	if len(d.Error) > 0 && string(d.Error) != "null" {
+		return extensions.ResultError, string(d.Error)
+	}
+	if len(d.Result) == 0 || string(d.Result) == "null" {
+		return extensions.ResultNotApplicable, "undefined decision"
+	}
+
+	var decision any
+	if err := json.Unmarshal(d.Result, &decision); err != nil {
+		return extensions.ResultError, err.Error()
+	}
+	switch value := decision.(type) {
+	case bool:
+		return boolResult(d.rule(), value)
+	case []any:
+		return violationsResult(value)
+	case map[string]any:
+		for _, key := range violationRules {
+			switch violations := value[key].(type) {
+			case []any:
+				if len(violations) > 0 {
+					return violationsResult(violations)
+				}
+			case bool:
+				if violations {
+					return boolResult(key, violations)
+				}
+			}
+		}
+		for _, key := range []string{"allow", "pass"} {
+			if allowed, ok := value[key].(bool); ok && !allowed {
+				return extensions.ResultFail, "decision denied"
+			}
+		}
+		return extensions.ResultPass, ""
+	default:
+		return extensions.ResultError, fmt.Sprintf("unsupported decision result %s", string(d.Result))
+	}
+}
+
+// rule returns the decision rule, the last segment of the decision path.
+func (d DecisionLog) rule() string {
+	segments := strings.Split(strings.Trim(d.Path, "/"), "/")
+	return segments[len(segments)-1]
+}
+
+// boolResult returns the Result and reason for a boolean decision of a rule. A true
+// decision is a violation for deny and violation rules and allowed for all other rules.
+func boolResult(rule string, value bool) (extensions.Result, string) {
+	if slices.Contains(violationRules, rule) {
+		value = !value
+	}
+	if value {
+		return extensions.ResultPass, ""
+	}
+	return extensions.ResultFail, "decision denied"
+}
+
+// violationsResult returns a failing Result with the violation messages as the reason
+// or a passing Result if there are no violations.
+func violationsResult(violations []any) (extensions.Result, string) {
+	if len(violations) == 0 {
+		return extensions.ResultPass, ""
+	}
+	messages := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		switch v := violation.(type) {
+		case string:
+			messages = append(messages, v)
+		case map[string]any:
+			if msg, ok := v["msg"].(string); ok {
+				messages = append(messages, msg)
+				continue
+			}
+			encoded, _ := json.Marshal(v)
+			messages = append(messages, string(encoded))
+		default:
+			messages = append(messages, fmt.Sprint(v))
+		}
+	}
+	return extensions.ResultFail, strings.Join(messages, "\n")
+}
+
+// isJSONArray returns whether the next non-whitespace character in the reader
+// starts a JSON array.
+func isJSONArray(reader *bufio.Reader) bool {
+	for {
+		b, err := reader.Peek(1)
+		if err != nil {
+			return false
+		}
+		switch b[0] {
+		case ' ', '\t', '\n', '\r':
+			if _, err := reader.ReadByte(); err != nil {
+				return false
+			}
+		default:
+			return b[0] == '['
+		}
+	}
+}
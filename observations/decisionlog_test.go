@@ -0,0 +1,118 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package observations
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+)
+
+func TestObservationsFromDecisionLogs(t *testing.T) {
+	file, err := os.Open("../testdata/opa-decision-logs.jsonl")
+	require.NoError(t, err)
+	defer file.Close()
+
+	observations, err := ObservationsFromDecisionLogs(file)
+	require.NoError(t, err)
+
+	type wantObservation struct {
+		result extensions.Result
+		reason string
+	}
+	wantObservations := map[string]wantObservation{
+		"check_1": {result: extensions.ResultFail, reason: "decision denied"},
+		"check_2": {result: extensions.ResultPass},
+		"check_3": {result: extensions.ResultFail, reason: "container must not run as root\nimage tag must be pinned"},
+		"check_4": {result: extensions.ResultNotApplicable, reason: "undefined decision"},
+	}
+	require.Len(t, observations, len(wantObservations))
+	for _, observation := range observations {
+		want, ok := wantObservations[observation.Title]
+		require.True(t, ok, "unexpected check %s", observation.Title)
+		result, found := results.ObservationResult(observation)
+		require.True(t, found)
+		require.Equal(t, want.result, result)
+		require.Equal(t, want.reason, results.ObservationReason(observation))
+	}
+	require.True(t, time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC).Equal(observations[0].Collected))
+}
+
+func TestDecisionLogsToObservations_BooleanRules(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		result     string
+		wantResult extensions.Result
+	}{
+		{
+			name:       "Valid/AllowTrue",
+			path:       "compliance/check_1/allow",
+			result:     "true",
+			wantResult: extensions.ResultPass,
+		},
+		{
+			name:       "Valid/DenyTrue",
+			path:       "compliance/check_1/deny",
+			result:     "true",
+			wantResult: extensions.ResultFail,
+		},
+		{
+			name:       "Valid/DenyFalse",
+			path:       "compliance/check_1/deny",
+			result:     "false",
+			wantResult: extensions.ResultPass,
+		},
+		{
+			name:       "Valid/ViolationTrue",
+			path:       "compliance/check_1/violation",
+			result:     "true",
+			wantResult: extensions.ResultFail,
+		},
+		{
+			name:       "Valid/DenyKeyTrue",
+			path:       "compliance/check_1",
+			result:     `{"deny": true}`,
+			wantResult: extensions.ResultFail,
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			logs := []DecisionLog{{Path: c.path, Result: json.RawMessage(c.result)}}
+			observations := DecisionLogsToObservations(logs, WithCheckIds("check_1"))
+			require.Len(t, observations, 1)
+			result, found := results.ObservationResult(observations[0])
+			require.True(t, found)
+			require.Equal(t, c.wantResult, result)
+		})
+	}
+}
+
+func TestDecisionLogsToObservations_WithCheckIds(t *testing.T) {
+	logs, err := ReadDecisionLogs(strings.NewReader(`[
+		{"path": "compliance/check_1/allow", "result": {"allow": true}},
+		{"path": "check-2", "result": {"deny": ["not allowed"]}},
+		{"path": "compliance/other/allow", "result": true}
+	]`))
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+
+	observations := DecisionLogsToObservations(logs, WithCheckIds("check_1", "check-2"))
+	require.Len(t, observations, 2)
+	result, _ := results.ObservationResult(observations[0])
+	require.Equal(t, extensions.ResultPass, result)
+	result, _ = results.ObservationResult(observations[1])
+	require.Equal(t, extensions.ResultFail, result)
+	require.Equal(t, "not allowed", results.ObservationReason(observations[1]))
+}
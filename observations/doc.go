@@ -0,0 +1,8 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package observations defines logic for converting the output of policy engines and test tools into
+// OSCAL Observations for use in Assessment Results.
+package observations
@@ -0,0 +1,179 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package observations
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+)
+
+const (
+	junitSource        = "JUnit test report"
+	junitSuiteElement  = "testsuite"
+	junitSuitesElement = "testsuites"
+	// junitTimestampLayout is the ISO 8601 layout without a time zone
+	// used by most JUnit reporters.
+	junitTimestampLayout = "2006-01-02T15:04:05"
+)
+
+// JUnitTestSuite defines a JUnit XML testsuite element.
+type JUnitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Timestamp string           `xml:"timestamp,attr,omitempty"`
+	TestCases []JUnitTestCase  `xml:"testcase"`
+	Suites    []JUnitTestSuite `xml:"testsuite"`
+}
+
+// JUnitTestCase defines a JUnit XML testcase element.
+type JUnitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr,omitempty"`
+	Failure   *JUnitMessage `xml:"failure,omitempty"`
+	Error     *JUnitMessage `xml:"error,omitempty"`
+	Skipped   *JUnitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+// JUnitMessage defines the details of a failed, errored, or skipped JUnit testcase.
+type JUnitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// ReadJUnit reads all testsuites from a JUnit XML report. The root element can be
+// either a testsuites or a testsuite element.
+func ReadJUnit(reader io.Reader) ([]JUnitTestSuite, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read junit report: %w", err)
+	}
+	var root struct {
+		XMLName xml.Name
+		Suites  []JUnitTestSuite `xml:"testsuite"`
+	}
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to decode junit report: %w", err)
+	}
+	switch root.XMLName.Local {
+	case junitSuitesElement:
+		return root.Suites, nil
+	case junitSuiteElement:
+		var suite JUnitTestSuite
+		if err := xml.Unmarshal(data, &suite); err != nil {
+			return nil, fmt.Errorf("failed to decode junit report: %w", err)
+		}
+		return []JUnitTestSuite{suite}, nil
+	default:
+		return nil, fmt.Errorf("unsupported root element %q", root.XMLName.Local)
+	}
+}
+
+// ObservationsFromJUnit reads a JUnit XML report and converts the testcases to OSCAL Observations.
+func ObservationsFromJUnit(reader io.Reader, opts ...ConvertOption) ([]oscalTypes.Observation, error) {
+	suites, err := ReadJUnit(reader)
+	if err != nil {
+		return nil, err
+	}
+	return JUnitToObservations(suites, opts...), nil
+}
+
+// JUnitToObservations converts JUnit testcases to OSCAL Observations with one Observation per check.
+//
+// The testcase name is used as the check id and is set with the assessment-check-id property. If `WithCheckIds` is set,
+// the testcase name qualified by the classname is used when the name is not a known check. Failures are recorded
+// as a fail result, errors as an error result, and skipped testcases as not-applicable. The testcase system-out is set as
+// the Observation remarks.
+func JUnitToObservations(suites []JUnitTestSuite, opts ...ConvertOption) []oscalTypes.Observation {
+	options := convertOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	observations := newCollector(junitSource, options)
+	resultsByCheck := make(map[string][]extensions.Result)
+	reasonsByCheck := make(map[string][]string)
+	outputByCheck := make(map[string][]string)
+
+	var convertSuite func(suite JUnitTestSuite)
+	convertSuite = func(suite JUnitTestSuite) {
+		for _, testCase := range suite.TestCases {
+			checkId, found := options.resolveCheckId(testCase.Name, testCase.qualifiedName())
+			if !found {
+				continue
+			}
+			observations.getOrCreate(checkId)
+			if timestamp, err := time.Parse(junitTimestampLayout, suite.Timestamp); err == nil {
+				observations.collected(checkId, timestamp)
+			} else if timestamp, err := time.Parse(time.RFC3339, suite.Timestamp); err == nil {
+				observations.collected(checkId, timestamp)
+			}
+
+			result, reason := testCase.result()
+			resultsByCheck[checkId] = append(resultsByCheck[checkId], result)
+			if reason != "" {
+				reasonsByCheck[checkId] = append(reasonsByCheck[checkId], reason)
+			}
+			if output := strings.TrimSpace(testCase.SystemOut); output != "" {
+				outputByCheck[checkId] = append(outputByCheck[checkId], output)
+			}
+		}
+		for _, nested := range suite.Suites {
+			convertSuite(nested)
+		}
+	}
+	for _, suite := range suites {
+		convertSuite(suite)
+	}
+
+	for checkId, checkResults := range resultsByCheck {
+		observation := observations.getOrCreate(checkId)
+		results.SetObservationResult(observation, results.AggregateResults(checkResults...), strings.Join(reasonsByCheck[checkId], "\n"))
+		if output, ok := outputByCheck[checkId]; ok {
+			observation.Remarks = strings.Join(output, "\n")
+		}
+	}
+	return observations.observations()
+}
+
+// result returns the Result and reason for a testcase.
+func (t JUnitTestCase) result() (extensions.Result, string) {
+	switch {
+	case t.Failure != nil:
+		return extensions.ResultFail, t.Failure.reason()
+	case t.Error != nil:
+		return extensions.ResultError, t.Error.reason()
+	case t.Skipped != nil:
+		return extensions.ResultNotApplicable, t.Skipped.reason()
+	default:
+		return extensions.ResultPass, ""
+	}
+}
+
+// qualifiedName returns the testcase name prefixed with the classname.
+func (t JUnitTestCase) qualifiedName() string {
+	if t.ClassName == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s.%s", t.ClassName, t.Name)
+}
+
+// reason returns the message or, if not set, the text of the element.
+func (m JUnitMessage) reason() string {
+	if m.Message != "" {
+		return m.Message
+	}
+	return strings.TrimSpace(m.Text)
+}
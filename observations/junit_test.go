@@ -0,0 +1,102 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package observations
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+)
+
+func TestObservationsFromJUnit(t *testing.T) {
+	type wantObservation struct {
+		result  extensions.Result
+		reason  string
+		remarks string
+	}
+
+	tests := []struct {
+		name             string
+		options          []ConvertOption
+		wantObservations map[string]wantObservation
+	}{
+		{
+			name: "Valid/Defaults",
+			wantObservations: map[string]wantObservation{
+				"check-1": {
+					result:  extensions.ResultFail,
+					reason:  "node worker-1 is missing labels",
+					remarks: "all pods have labels\nchecked 3 nodes",
+				},
+				"test_audit_logging": {
+					result: extensions.ResultError,
+					reason: "connection refused",
+				},
+				"test_encryption": {
+					result: extensions.ResultNotApplicable,
+					reason: "encryption not configured",
+				},
+			},
+		},
+		{
+			name:    "Valid/WithCheckIds",
+			options: []ConvertOption{WithCheckIds("tests.test_cluster.check-1", "tests.test_cluster.test_audit_logging")},
+			wantObservations: map[string]wantObservation{
+				"tests.test_cluster.check-1": {
+					result:  extensions.ResultPass,
+					remarks: "all pods have labels",
+				},
+				"tests.test_cluster.test_audit_logging": {
+					result: extensions.ResultError,
+					reason: "connection refused",
+				},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			file, err := os.Open("../testdata/junit-report.xml")
+			require.NoError(t, err)
+			defer file.Close()
+
+			observations, err := ObservationsFromJUnit(file, c.options...)
+			require.NoError(t, err)
+			require.Len(t, observations, len(c.wantObservations))
+
+			for _, observation := range observations {
+				check, found := extensions.GetTrestleProp(extensions.AssessmentCheckIdProp, *observation.Props)
+				require.True(t, found)
+				want, ok := c.wantObservations[check.Value]
+				require.True(t, ok, "unexpected check %s", check.Value)
+
+				result, found := results.ObservationResult(observation)
+				require.True(t, found)
+				require.Equal(t, want.result, result)
+				require.Equal(t, want.reason, results.ObservationReason(observation))
+				require.Equal(t, want.remarks, observation.Remarks)
+				require.True(t, time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC).Equal(observation.Collected))
+			}
+		})
+	}
+}
+
+func TestReadJUnit(t *testing.T) {
+	suites, err := ReadJUnit(strings.NewReader(`<testsuite name="suite"><testcase name="check-1"/></testsuite>`))
+	require.NoError(t, err)
+	require.Len(t, suites, 1)
+	require.Equal(t, "suite", suites[0].Name)
+	require.Len(t, suites[0].TestCases, 1)
+
+	_, err = ReadJUnit(strings.NewReader(`<report/>`))
+	require.EqualError(t, err, "unsupported root element \"report\"")
+}
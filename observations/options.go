@@ -0,0 +1,118 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package observations
+
+import (
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+)
+
+type convertOpts struct {
+	checkIds     set.Set[string]
+	uuid         models.UUIDFunc
+	clock        models.Clock
+	implicitPass bool
+}
+
+func (c *convertOpts) defaults() {
+	c.uuid = models.RandomUUID
+	c.clock = models.SystemClock
+}
+
+// ConvertOption defines an option to tune the behavior of the
+// Observation conversion functions.
+type ConvertOption func(opts *convertOpts)
+
+// WithCheckIds is a ConvertOption that sets the known check ids. When set,
+// only results that map to one of the check ids are converted.
+func WithCheckIds(checkIds ...string) ConvertOption {
+	return func(opts *convertOpts) {
+		if opts.checkIds == nil {
+			opts.checkIds = set.New[string]()
+		}
+		for _, checkId := range checkIds {
+			opts.checkIds.Add(checkId)
+		}
+	}
+}
+
+// WithUUIDFunc is a ConvertOption that sets the source of UUIDs for the converted
+// Observations. Use models.ContentUUID to generate the same UUIDs for the same checks.
+func WithUUIDFunc(uuidFunc models.UUIDFunc) ConvertOption {
+	return func(opts *convertOpts) {
+		opts.uuid = uuidFunc
+	}
+}
+
+// WithClock is a ConvertOption that sets the collection time for converted
+// Observations when the input has no timestamp.
+func WithClock(clock models.Clock) ConvertOption {
+	return func(opts *convertOpts) {
+		opts.clock = clock
+	}
+}
+
+// WithImplicitPass is a ConvertOption that records all rules evaluated by a tool without
+// results as passing. Use it only when the tool reports every evaluated rule in the input.
+func WithImplicitPass() ConvertOption {
+	return func(opts *convertOpts) {
+		opts.implicitPass = true
+	}
+}
+
+// CheckIdsFromPlan returns the check ids from the validation components in the
+// Assessment Plan assessment assets. The check ids can be used with `WithCheckIds`.
+func CheckIdsFromPlan(plan oscalTypes.AssessmentPlan) []string {
+	var checkIds []string
+	if plan.AssessmentAssets == nil || plan.AssessmentAssets.Components == nil {
+		return checkIds
+	}
+	for _, component := range *plan.AssessmentAssets.Components {
+		if component.Props == nil {
+			continue
+		}
+		for _, prop := range extensions.FindAllProps(*component.Props, extensions.WithName(extensions.CheckIdProp)) {
+			checkIds = append(checkIds, prop.Value)
+		}
+	}
+	return checkIds
+}
+
+// CheckIdsFromDefinition returns the check ids from the validation components in a
+// Component Definition. The check ids can be used with `WithCheckIds`.
+func CheckIdsFromDefinition(definition oscalTypes.ComponentDefinition) []string {
+	var checkIds []string
+	if definition.Components == nil {
+		return checkIds
+	}
+	for _, component := range *definition.Components {
+		if component.Type != string(components.Validation) || component.Props == nil {
+			continue
+		}
+		for _, prop := range extensions.FindAllProps(*component.Props, extensions.WithName(extensions.CheckIdProp)) {
+			checkIds = append(checkIds, prop.Value)
+		}
+	}
+	return checkIds
+}
+
+// resolveCheckId returns the first candidate that is a known check id. If no
+// check ids are set, the first non-empty candidate is returned.
+func (c convertOpts) resolveCheckId(candidates ...string) (string, bool) {
+	for _, candidate := range candidates {
+		if candidate == "" {
+			continue
+		}
+		if c.checkIds == nil || c.checkIds.Has(candidate) {
+			return candidate, true
+		}
+	}
+	return "", false
+}
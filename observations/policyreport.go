@@ -0,0 +1,208 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package observations
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"gopkg.in/yaml.v3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+)
+
+const (
+	policyReportKind        = "PolicyReport"
+	clusterPolicyReportKind = "ClusterPolicyReport"
+	policyReportSource      = "PolicyReport"
+	inventoryItemSubject    = "inventory-item"
+)
+
+// PolicyReport defines the fields used from a wgpolicyk8s.io PolicyReport
+// or ClusterPolicyReport resource.
+type PolicyReport struct {
+	APIVersion string               `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string               `json:"kind" yaml:"kind"`
+	Metadata   ObjectMeta           `json:"metadata" yaml:"metadata"`
+	Scope      *ObjectReference     `json:"scope,omitempty" yaml:"scope,omitempty"`
+	Results    []PolicyReportResult `json:"results,omitempty" yaml:"results,omitempty"`
+}
+
+// ObjectMeta defines the metadata fields used from a PolicyReport.
+type ObjectMeta struct {
+	Name      string `json:"name" yaml:"name"`
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+}
+
+// ObjectReference identifies a Kubernetes resource evaluated in a PolicyReport.
+type ObjectReference struct {
+	APIVersion string `json:"apiVersion,omitempty" yaml:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty" yaml:"kind,omitempty"`
+	Namespace  string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Name       string `json:"name,omitempty" yaml:"name,omitempty"`
+	UID        string `json:"uid,omitempty" yaml:"uid,omitempty"`
+}
+
+// PolicyReportResult defines a single policy rule result in a PolicyReport.
+type PolicyReportResult struct {
+	Source    string            `json:"source,omitempty" yaml:"source,omitempty"`
+	Policy    string            `json:"policy" yaml:"policy"`
+	Rule      string            `json:"rule,omitempty" yaml:"rule,omitempty"`
+	Result    string            `json:"result,omitempty" yaml:"result,omitempty"`
+	Message   string            `json:"message,omitempty" yaml:"message,omitempty"`
+	Severity  string            `json:"severity,omitempty" yaml:"severity,omitempty"`
+	Timestamp *Timestamp        `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+	Resources []ObjectReference `json:"resources,omitempty" yaml:"resources,omitempty"`
+}
+
+// Timestamp defines the time a PolicyReport result was recorded.
+type Timestamp struct {
+	Seconds int64 `json:"seconds" yaml:"seconds"`
+	Nanos   int32 `json:"nanos,omitempty" yaml:"nanos,omitempty"`
+}
+
+// ReadPolicyReports reads all PolicyReport and ClusterPolicyReport resources from YAML or JSON input.
+// Multi-document YAML and List kinds are supported.
+func ReadPolicyReports(reader io.Reader) ([]PolicyReport, error) {
+	var reports []PolicyReport
+	decoder := yaml.NewDecoder(reader)
+	for {
+		var document struct {
+			Kind  string      `yaml:"kind"`
+			Items []yaml.Node `yaml:"items"`
+		}
+		var node yaml.Node
+		if err := decoder.Decode(&node); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode policy reports: %w", err)
+		}
+		if err := node.Decode(&document); err != nil {
+			return nil, fmt.Errorf("failed to decode policy reports: %w", err)
+		}
+
+		nodes := []yaml.Node{node}
+		if strings.HasSuffix(document.Kind, "List") {
+			nodes = document.Items
+		}
+		for _, item := range nodes {
+			var report PolicyReport
+			if err := item.Decode(&report); err != nil {
+				return nil, fmt.Errorf("failed to decode policy report: %w", err)
+			}
+			if report.Kind != policyReportKind && report.Kind != clusterPolicyReportKind {
+				return nil, fmt.Errorf("unsupported kind %q", report.Kind)
+			}
+			reports = append(reports, report)
+		}
+	}
+	return reports, nil
+}
+
+// ObservationsFromPolicyReports reads PolicyReport resources from YAML or JSON input and converts them to OSCAL Observations.
+func ObservationsFromPolicyReports(reader io.Reader, opts ...ConvertOption) ([]oscalTypes.Observation, error) {
+	reports, err := ReadPolicyReports(reader)
+	if err != nil {
+		return nil, err
+	}
+	return PolicyReportsToObservations(reports, opts...), nil
+}
+
+// PolicyReportsToObservations converts PolicyReport results to OSCAL Observations with one Observation per check.
+//
+// The policy name is used as the check id and is set with the assessment-check-id property. If `WithCheckIds` is set,
+// the rule name is used when the policy name is not a known check. Each evaluated resource is added as an Observation subject
+// with the result and message. Results with the "warn" status are recorded as failures and "skip" as not-applicable.
+// Results without resources or a report scope are aggregated on the Observation with a failure taking precedence.
+func PolicyReportsToObservations(reports []PolicyReport, opts ...ConvertOption) []oscalTypes.Observation {
+	options := convertOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	observations := newCollector(policyReportSource, options)
+	var unscopedChecks []string
+	resultsByCheck := make(map[string][]extensions.Result)
+	reasonsByCheck := make(map[string][]string)
+	for _, report := range reports {
+		for _, reportResult := range report.Results {
+			checkId, found := options.resolveCheckId(reportResult.Policy, reportResult.Rule)
+			if !found {
+				continue
+			}
+
+			resources := reportResult.Resources
+			if len(resources) == 0 && report.Scope != nil {
+				resources = []ObjectReference{*report.Scope}
+			}
+			for _, resource := range resources {
+				subject := oscalTypes.SubjectReference{
+					SubjectUuid: uuid.NewUUIDWithSource(resource.identifier()),
+					Type:        inventoryItemSubject,
+					Title:       resource.title(),
+				}
+				results.SetSubjectResult(&subject, policyReportResult(reportResult.Result), reportResult.Message)
+				observations.addSubject(checkId, subject)
+			}
+
+			observations.getOrCreate(checkId)
+			if len(resources) == 0 {
+				if _, seen := resultsByCheck[checkId]; !seen {
+					unscopedChecks = append(unscopedChecks, checkId)
+				}
+				resultsByCheck[checkId] = append(resultsByCheck[checkId], policyReportResult(reportResult.Result))
+				if reportResult.Message != "" {
+					reasonsByCheck[checkId] = append(reasonsByCheck[checkId], reportResult.Message)
+				}
+			}
+			if reportResult.Timestamp != nil {
+				observations.collected(checkId, time.Unix(reportResult.Timestamp.Seconds, int64(reportResult.Timestamp.Nanos)))
+			}
+		}
+	}
+
+	for _, checkId := range unscopedChecks {
+		observation := observations.getOrCreate(checkId)
+		results.SetObservationResult(observation, results.AggregateResults(resultsByCheck[checkId]...), strings.Join(reasonsByCheck[checkId], "\n"))
+	}
+	return observations.observations()
+}
+
+// policyReportResult maps a PolicyReport result status to a Result.
+func policyReportResult(status string) extensions.Result {
+	switch strings.ToLower(status) {
+	case "pass":
+		return extensions.ResultPass
+	case "fail", "warn":
+		return extensions.ResultFail
+	case "skip":
+		return extensions.ResultNotApplicable
+	default:
+		return extensions.ResultError
+	}
+}
+
+// identifier returns a stable identifier for a resource.
+func (o ObjectReference) identifier() string {
+	if o.UID != "" {
+		return o.UID
+	}
+	return path.Join(o.APIVersion, o.Kind, o.Namespace, o.Name)
+}
+
+// title returns a human-readable name for a resource.
+func (o ObjectReference) title() string {
+	return path.Join(o.Kind, o.Namespace, o.Name)
+}
@@ -0,0 +1,170 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package observations
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/transformers"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+func TestObservationsFromPolicyReports(t *testing.T) {
+	tests := []struct {
+		name          string
+		options       []ConvertOption
+		wantChecks    []string
+		wantResults   map[string]extensions.Result
+		wantSubjects  map[string]int
+		wantCollected map[string]time.Time
+	}{
+		{
+			name:       "Valid/Defaults",
+			wantChecks: []string{"check-1", "disallow-latest-tag", "require-ns-labels"},
+			wantResults: map[string]extensions.Result{
+				"check-1":             extensions.ResultFail,
+				"disallow-latest-tag": extensions.ResultNotApplicable,
+				"require-ns-labels":   extensions.ResultFail,
+			},
+			wantSubjects: map[string]int{
+				"check-1":             2,
+				"disallow-latest-tag": 1,
+				"require-ns-labels":   1,
+			},
+			wantCollected: map[string]time.Time{
+				"check-1": time.Unix(1735693200, 0),
+			},
+		},
+		{
+			name:       "Valid/WithCheckIds",
+			options:    []ConvertOption{WithCheckIds("check-1", "check-2")},
+			wantChecks: []string{"check-1", "check-2"},
+			wantResults: map[string]extensions.Result{
+				"check-1": extensions.ResultFail,
+				"check-2": extensions.ResultFail,
+			},
+			wantSubjects: map[string]int{
+				"check-1": 2,
+				"check-2": 1,
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			file, err := os.Open("../testdata/policy-report.yaml")
+			require.NoError(t, err)
+			defer file.Close()
+
+			observations, err := ObservationsFromPolicyReports(file, c.options...)
+			require.NoError(t, err)
+
+			var gotChecks []string
+			for _, observation := range observations {
+				check, found := extensions.GetTrestleProp(extensions.AssessmentCheckIdProp, *observation.Props)
+				require.True(t, found)
+				require.Equal(t, check.Value, observation.Title)
+				gotChecks = append(gotChecks, check.Value)
+
+				result, found := results.ObservationResult(observation)
+				require.True(t, found)
+				require.Equal(t, c.wantResults[check.Value], result)
+				require.Len(t, *observation.Subjects, c.wantSubjects[check.Value])
+				require.Equal(t, []string{"TEST"}, observation.Methods)
+				require.False(t, observation.Collected.IsZero())
+				if collected, ok := c.wantCollected[check.Value]; ok {
+					require.True(t, collected.Equal(observation.Collected))
+				}
+			}
+			require.Equal(t, c.wantChecks, gotChecks)
+		})
+	}
+}
+
+func TestPolicyReportsToObservations_UnscopedResults(t *testing.T) {
+	reports := []PolicyReport{
+		{
+			Kind: policyReportKind,
+			Results: []PolicyReportResult{
+				{Policy: "check-1", Result: "fail", Message: "first pass failed"},
+				{Policy: "check-1", Result: "pass"},
+			},
+		},
+	}
+
+	observations := PolicyReportsToObservations(reports)
+	require.Len(t, observations, 1)
+	require.Nil(t, observations[0].Subjects)
+	result, found := results.ObservationResult(observations[0])
+	require.True(t, found)
+	require.Equal(t, extensions.ResultFail, result)
+	require.Equal(t, "first pass failed", results.ObservationReason(observations[0]))
+}
+
+func TestObservationsFromPolicyReports_Reproducible(t *testing.T) {
+	clock := models.FixedClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	convert := func() []oscalTypes.Observation {
+		file, err := os.Open("../testdata/policy-report.yaml")
+		require.NoError(t, err)
+		defer file.Close()
+		observations, err := ObservationsFromPolicyReports(file, WithUUIDFunc(models.ContentUUID("test")), WithClock(clock))
+		require.NoError(t, err)
+		return observations
+	}
+
+	first := convert()
+	require.Equal(t, first, convert())
+	for _, observation := range first {
+		if observation.Title != "check-1" {
+			require.True(t, clock().Equal(observation.Collected))
+		}
+	}
+}
+
+func TestObservationsFromPolicyReports_AssessmentResults(t *testing.T) {
+	file, err := os.Open("../testdata/policy-report.yaml")
+	require.NoError(t, err)
+	defer file.Close()
+	observations, err := ObservationsFromPolicyReports(file, WithCheckIds("check-1"))
+	require.NoError(t, err)
+	require.Len(t, observations, 1)
+
+	planFile, err := os.Open("../testdata/test-ap.json")
+	require.NoError(t, err)
+	defer planFile.Close()
+	plan, err := models.NewAssessmentPlan(planFile, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	assessmentResults, err := transformers.AssessmentPlanToAssessmentResults(*plan, "importPath", transformers.WithObservations(observations))
+	require.NoError(t, err)
+	require.Len(t, assessmentResults.Results, 1)
+	result := assessmentResults.Results[0]
+	require.Len(t, *result.Observations, 1)
+	require.Equal(t, observations[0].UUID, (*result.Observations)[0].UUID)
+	require.Equal(t, []string{"TEST"}, (*result.Observations)[0].Methods)
+
+	validator := validation.NewSchemaValidator()
+	require.NoError(t, validator.Validate(oscalTypes.OscalModels{AssessmentResults: assessmentResults}))
+}
+
+func TestReadPolicyReports(t *testing.T) {
+	reports, err := ReadPolicyReports(strings.NewReader(`{"kind": "PolicyReport", "metadata": {"name": "test"}, "results": [{"policy": "check-1", "result": "pass"}]}`))
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	require.Equal(t, "test", reports[0].Metadata.Name)
+
+	_, err = ReadPolicyReports(strings.NewReader("kind: ConfigMap"))
+	require.EqualError(t, err, "unsupported kind \"ConfigMap\"")
+}
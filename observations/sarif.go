@@ -0,0 +1,317 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package observations
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+)
+
+const (
+	sarifSource     = "SARIF report"
+	resourceSubject = "resource"
+)
+
+// SARIFLog defines the fields used from a SARIF 2.1.0 log.
+type SARIFLog struct {
+	Version string     `json:"version"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+// SARIFRun defines a single run of an analysis tool in a SARIF log.
+type SARIFRun struct {
+	Tool        SARIFTool         `json:"tool"`
+	Invocations []SARIFInvocation `json:"invocations,omitempty"`
+	Results     []SARIFResult     `json:"results,omitempty"`
+}
+
+// SARIFTool defines the analysis tool for a SARIF run.
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+// SARIFDriver defines the tool component and the rules it evaluates.
+type SARIFDriver struct {
+	Name  string      `json:"name"`
+	Rules []SARIFRule `json:"rules,omitempty"`
+}
+
+// SARIFRule defines a rule evaluated by the analysis tool.
+type SARIFRule struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// SARIFInvocation defines the invocation details of a SARIF run.
+type SARIFInvocation struct {
+	EndTimeUTC                     *time.Time                   `json:"endTimeUtc,omitempty"`
+	RuleConfigurationOverrides     []SARIFConfigurationOverride `json:"ruleConfigurationOverrides,omitempty"`
+	ToolExecutionNotifications     []SARIFNotification          `json:"toolExecutionNotifications,omitempty"`
+	ToolConfigurationNotifications []SARIFNotification          `json:"toolConfigurationNotifications,omitempty"`
+}
+
+// SARIFReference defines a reference to a rule by id or index in the tool driver.
+type SARIFReference struct {
+	ID    string `json:"id,omitempty"`
+	Index *int   `json:"index,omitempty"`
+}
+
+// SARIFConfigurationOverride defines the configuration of a rule for an invocation.
+type SARIFConfigurationOverride struct {
+	Descriptor    SARIFReference     `json:"descriptor"`
+	Configuration SARIFConfiguration `json:"configuration"`
+}
+
+// SARIFConfiguration defines the configuration of a rule.
+type SARIFConfiguration struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// SARIFNotification defines a notification from the tool for an invocation.
+type SARIFNotification struct {
+	AssociatedRule *SARIFReference `json:"associatedRule,omitempty"`
+	Level          string          `json:"level,omitempty"`
+	Message        SARIFMessage    `json:"message"`
+}
+
+// SARIFResult defines a single result from a SARIF run.
+type SARIFResult struct {
+	RuleID    string          `json:"ruleId,omitempty"`
+	RuleIndex *int            `json:"ruleIndex,omitempty"`
+	Kind      string          `json:"kind,omitempty"`
+	Level     string          `json:"level,omitempty"`
+	Message   SARIFMessage    `json:"message"`
+	Locations []SARIFLocation `json:"locations,omitempty"`
+}
+
+// SARIFMessage defines the message for a SARIF result.
+type SARIFMessage struct {
+	Text string `json:"text,omitempty"`
+}
+
+// SARIFLocation defines the location of a SARIF result.
+type SARIFLocation struct {
+	PhysicalLocation *SARIFPhysicalLocation `json:"physicalLocation,omitempty"`
+}
+
+// SARIFPhysicalLocation defines the artifact and region of a SARIF result.
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+	Region           *SARIFRegion          `json:"region,omitempty"`
+}
+
+// SARIFArtifactLocation defines the location of an artifact.
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// SARIFRegion defines a region within an artifact.
+type SARIFRegion struct {
+	StartLine int `json:"startLine,omitempty"`
+}
+
+// ReadSARIF reads a SARIF log from JSON input.
+func ReadSARIF(reader io.Reader) (SARIFLog, error) {
+	var log SARIFLog
+	if err := json.NewDecoder(reader).Decode(&log); err != nil {
+		return SARIFLog{}, fmt.Errorf("failed to decode sarif log: %w", err)
+	}
+	return log, nil
+}
+
+// ObservationsFromSARIF reads a SARIF log and converts the results to OSCAL Observations.
+func ObservationsFromSARIF(reader io.Reader, opts ...ConvertOption) ([]oscalTypes.Observation, error) {
+	log, err := ReadSARIF(reader)
+	if err != nil {
+		return nil, err
+	}
+	return SARIFToObservations(log, opts...), nil
+}
+
+// SARIFToObservations converts SARIF results to OSCAL Observations with one Observation per check.
+//
+// The SARIF rule id is used as the check id and is set with the assessment-check-id property. If `WithCheckIds` is set,
+// the rule name is used when the rule id is not a known check. Each result location is added as an Observation subject with the
+// result, the message as the reason, and an evidence link to the artifact. Results with the "pass" kind are recorded as passing,
+// "notApplicable" and "informational" as not-applicable, and all others as failures.
+//
+// Rules in the tool driver without results are only recorded when an invocation shows the rule was evaluated. Rules with a
+// configuration override are recorded as passing, or not-applicable when disabled. Rules with a notification are recorded
+// as passing, or as an error for "error" level notifications, with the notification message in the remarks. If
+// `WithImplicitPass` is set, all other rules in the tool driver without results are recorded as passing.
+func SARIFToObservations(log SARIFLog, opts ...ConvertOption) []oscalTypes.Observation {
+	options := convertOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	observations := newCollector(sarifSource, options)
+	unlocatedByCheck := make(map[string][]extensions.Result)
+	for _, run := range log.Runs {
+		var endTime time.Time
+		for _, invocation := range run.Invocations {
+			if invocation.EndTimeUTC != nil && invocation.EndTimeUTC.After(endTime) {
+				endTime = *invocation.EndTimeUTC
+			}
+		}
+
+		withResults := make(map[string]bool)
+		for _, sarifResult := range run.Results {
+			rule := run.rule(sarifResult)
+			withResults[rule.ID] = true
+			checkId, found := options.resolveCheckId(rule.ID, rule.Name)
+			if !found {
+				continue
+			}
+			observations.getOrCreate(checkId)
+			observations.collected(checkId, endTime)
+
+			result := sarifResult.result()
+			located := false
+			for _, location := range sarifResult.Locations {
+				if location.PhysicalLocation == nil || location.PhysicalLocation.ArtifactLocation.URI == "" {
+					continue
+				}
+				located = true
+				uri := location.PhysicalLocation.ArtifactLocation.URI
+				subject := oscalTypes.SubjectReference{
+					SubjectUuid: uuid.NewUUIDWithSource(location.PhysicalLocation.title()),
+					Type:        resourceSubject,
+					Title:       location.PhysicalLocation.title(),
+				}
+				results.SetSubjectResult(&subject, result, sarifResult.Message.Text)
+				results.AddSubjectEvidence(&subject, uri)
+				observations.addSubject(checkId, subject)
+			}
+			if !located {
+				observation := observations.getOrCreate(checkId)
+				unlocatedByCheck[checkId] = append(unlocatedByCheck[checkId], result)
+				if sarifResult.Message.Text != "" {
+					observation.Remarks = strings.TrimSpace(strings.Join([]string{observation.Remarks, sarifResult.Message.Text}, "\n"))
+				}
+			}
+		}
+
+		// Rules without results are only recorded when the run shows they were evaluated.
+		evaluated := make(map[string][]extensions.Result)
+		remarks := make(map[string][]string)
+		for _, invocation := range run.Invocations {
+			for _, override := range invocation.RuleConfigurationOverrides {
+				rule := run.ruleByReference(override.Descriptor)
+				result := extensions.ResultPass
+				if override.Configuration.Enabled != nil && !*override.Configuration.Enabled {
+					result = extensions.ResultNotApplicable
+				}
+				evaluated[rule.ID] = append(evaluated[rule.ID], result)
+			}
+			for _, notifications := range [][]SARIFNotification{invocation.ToolConfigurationNotifications, invocation.ToolExecutionNotifications} {
+				for _, notification := range notifications {
+					if notification.AssociatedRule == nil {
+						continue
+					}
+					rule := run.ruleByReference(*notification.AssociatedRule)
+					result := extensions.ResultPass
+					if notification.Level == "error" {
+						result = extensions.ResultError
+					}
+					evaluated[rule.ID] = append(evaluated[rule.ID], result)
+					if notification.Message.Text != "" {
+						remarks[rule.ID] = append(remarks[rule.ID], notification.Message.Text)
+					}
+				}
+			}
+		}
+
+		for _, rule := range run.Tool.Driver.Rules {
+			if withResults[rule.ID] {
+				continue
+			}
+			ruleResults, found := evaluated[rule.ID]
+			if !found {
+				if !options.implicitPass {
+					continue
+				}
+				ruleResults = []extensions.Result{extensions.ResultPass}
+			}
+			checkId, found := options.resolveCheckId(rule.ID, rule.Name)
+			if !found {
+				continue
+			}
+			observation := observations.getOrCreate(checkId)
+			observations.collected(checkId, endTime)
+			unlocatedByCheck[checkId] = append(unlocatedByCheck[checkId], ruleResults...)
+			if len(remarks[rule.ID]) > 0 {
+				observation.Remarks = strings.TrimSpace(strings.Join(append([]string{observation.Remarks}, remarks[rule.ID]...), "\n"))
+			}
+		}
+	}
+
+	// Results without a location are set on the Observation, so the subject
+	// results are included to keep the aggregated result.
+	for checkId, unlocated := range unlocatedByCheck {
+		observation := observations.getOrCreate(checkId)
+		if observation.Subjects != nil {
+			for _, subject := range *observation.Subjects {
+				if result, ok := results.SubjectResult(subject); ok {
+					unlocated = append(unlocated, result)
+				}
+			}
+		}
+		results.SetObservationResult(observation, results.AggregateResults(unlocated...), "")
+	}
+	return observations.observations()
+}
+
+// rule returns the rule for a result using the rule index or the rule id.
+func (r SARIFRun) rule(result SARIFResult) SARIFRule {
+	return r.ruleByReference(SARIFReference{ID: result.RuleID, Index: result.RuleIndex})
+}
+
+// ruleByReference returns the rule for a reference using the index or the id.
+func (r SARIFRun) ruleByReference(reference SARIFReference) SARIFRule {
+	if reference.Index != nil && *reference.Index >= 0 && *reference.Index < len(r.Tool.Driver.Rules) {
+		rule := r.Tool.Driver.Rules[*reference.Index]
+		if reference.ID == "" || rule.ID == reference.ID {
+			return rule
+		}
+	}
+	for _, rule := range r.Tool.Driver.Rules {
+		if rule.ID == reference.ID {
+			return rule
+		}
+	}
+	return SARIFRule{ID: reference.ID}
+}
+
+// result maps the SARIF result kind to a Result.
+func (r SARIFResult) result() extensions.Result {
+	switch r.Kind {
+	case "pass":
+		return extensions.ResultPass
+	case "notApplicable", "informational":
+		return extensions.ResultNotApplicable
+	default:
+		return extensions.ResultFail
+	}
+}
+
+// title returns the artifact URI with the start line, if set.
+func (p SARIFPhysicalLocation) title() string {
+	if p.Region != nil && p.Region.StartLine > 0 {
+		return fmt.Sprintf("%s:%d", p.ArtifactLocation.URI, p.Region.StartLine)
+	}
+	return p.ArtifactLocation.URI
+}
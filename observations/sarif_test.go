@@ -0,0 +1,173 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package observations
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/transformers"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+func TestObservationsFromSARIF(t *testing.T) {
+	file, err := os.Open("../testdata/sarif-report.json")
+	require.NoError(t, err)
+	defer file.Close()
+
+	observations, err := ObservationsFromSARIF(file)
+	require.NoError(t, err)
+	require.Len(t, observations, 4)
+
+	located := observations[0]
+	require.Equal(t, "CKV_K8S_21", located.Title)
+	require.True(t, time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC).Equal(located.Collected))
+	result, found := results.ObservationResult(located)
+	require.True(t, found)
+	require.Equal(t, extensions.ResultFail, result)
+
+	subjects := *located.Subjects
+	require.Len(t, subjects, 2)
+	require.Equal(t, "manifests/deployment.yaml:4", subjects[0].Title)
+	require.Equal(t, "The default namespace should not be used", results.SubjectReason(subjects[0]))
+	require.Equal(t, []string{"manifests/deployment.yaml"}, results.SubjectEvidence(subjects[0]))
+	subjectResult, found := results.SubjectResult(subjects[1])
+	require.True(t, found)
+	require.Equal(t, extensions.ResultPass, subjectResult)
+
+	unlocated := observations[1]
+	require.Equal(t, "CKV_K8S_22", unlocated.Title)
+	require.Nil(t, unlocated.Subjects)
+	require.Equal(t, "No containers found", unlocated.Remarks)
+	result, found = results.ObservationResult(unlocated)
+	require.True(t, found)
+	require.Equal(t, extensions.ResultNotApplicable, result)
+
+	// A rule without results is recorded when configured for the run
+	configured := observations[2]
+	require.Equal(t, "CKV_K8S_24", configured.Title)
+	require.Nil(t, configured.Subjects)
+	require.True(t, time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC).Equal(configured.Collected))
+	result, found = results.ObservationResult(configured)
+	require.True(t, found)
+	require.Equal(t, extensions.ResultPass, result)
+
+	notified := observations[3]
+	require.Equal(t, "CKV_K8S_25", notified.Title)
+	require.Equal(t, "Failed to evaluate rule", notified.Remarks)
+	result, found = results.ObservationResult(notified)
+	require.True(t, found)
+	require.Equal(t, extensions.ResultError, result)
+}
+
+func TestObservationsFromSARIF_ImplicitPass(t *testing.T) {
+	disabled := false
+	tests := []struct {
+		name       string
+		invocation SARIFInvocation
+		options    []ConvertOption
+		wantResult map[string]extensions.Result
+	}{
+		{
+			name:       "Valid/NotEvaluated",
+			wantResult: map[string]extensions.Result{"rule-1": extensions.ResultFail},
+		},
+		{
+			name:       "Valid/WithImplicitPass",
+			options:    []ConvertOption{WithImplicitPass()},
+			wantResult: map[string]extensions.Result{"rule-1": extensions.ResultFail, "rule-2": extensions.ResultPass},
+		},
+		{
+			name: "Valid/DisabledOverride",
+			invocation: SARIFInvocation{
+				RuleConfigurationOverrides: []SARIFConfigurationOverride{
+					{Descriptor: SARIFReference{ID: "rule-2"}, Configuration: SARIFConfiguration{Enabled: &disabled}},
+				},
+			},
+			wantResult: map[string]extensions.Result{"rule-1": extensions.ResultFail, "rule-2": extensions.ResultNotApplicable},
+		},
+		{
+			name: "Valid/ConfigurationNotification",
+			invocation: SARIFInvocation{
+				ToolConfigurationNotifications: []SARIFNotification{
+					{AssociatedRule: &SARIFReference{ID: "rule-2"}, Level: "warning", Message: SARIFMessage{Text: "Rule configured"}},
+				},
+			},
+			wantResult: map[string]extensions.Result{"rule-1": extensions.ResultFail, "rule-2": extensions.ResultPass},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			log := SARIFLog{
+				Runs: []SARIFRun{
+					{
+						Tool: SARIFTool{
+							Driver: SARIFDriver{Name: "tool", Rules: []SARIFRule{{ID: "rule-1"}, {ID: "rule-2"}}},
+						},
+						Invocations: []SARIFInvocation{c.invocation},
+						Results:     []SARIFResult{{RuleID: "rule-1", Level: "error"}},
+					},
+				},
+			}
+			observations := SARIFToObservations(log, c.options...)
+			gotResult := make(map[string]extensions.Result)
+			for _, observation := range observations {
+				result, found := results.ObservationResult(observation)
+				require.True(t, found)
+				gotResult[observation.Title] = result
+			}
+			require.Equal(t, c.wantResult, gotResult)
+		})
+	}
+}
+
+func TestObservationsFromSARIF_AssessmentResults(t *testing.T) {
+	planFile, err := os.Open("../testdata/test-ap.json")
+	require.NoError(t, err)
+	defer planFile.Close()
+	plan, err := models.NewAssessmentPlan(planFile, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	checkIds := CheckIdsFromPlan(*plan)
+	require.Equal(t, []string{"check-1"}, checkIds)
+
+	file, err := os.Open("../testdata/sarif-report.json")
+	require.NoError(t, err)
+	defer file.Close()
+	observations, err := ObservationsFromSARIF(file, WithCheckIds(checkIds...))
+	require.NoError(t, err)
+	require.Len(t, observations, 1)
+	require.Equal(t, "check-1", observations[0].Title)
+
+	assessmentResults, err := transformers.AssessmentPlanToAssessmentResults(*plan, "importPath", transformers.WithObservations(observations))
+	require.NoError(t, err)
+	result := assessmentResults.Results[0]
+	require.Len(t, *result.Observations, 1)
+	require.Equal(t, observations[0].UUID, (*result.Observations)[0].UUID)
+	require.Equal(t, []string{"TEST"}, (*result.Observations)[0].Methods)
+
+	validator := validation.NewSchemaValidator()
+	require.NoError(t, validator.Validate(oscalTypes.OscalModels{AssessmentResults: assessmentResults}))
+}
+
+func TestCheckIdsFromDefinition(t *testing.T) {
+	file, err := os.Open("../testdata/component-definition-test.json")
+	require.NoError(t, err)
+	defer file.Close()
+	definition, err := models.NewComponentDefinition(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	checkIds := CheckIdsFromDefinition(*definition)
+	require.Contains(t, checkIds, "etcd_cert_file")
+}
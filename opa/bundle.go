@@ -0,0 +1,173 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package opa
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/plans"
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/settings"
+)
+
+const (
+	// DefaultRoot is the default bundle root for the data document. Rego policies
+	// can access the data under data.oscal.
+	DefaultRoot = "oscal"
+
+	dataFile     = "data.json"
+	manifestFile = ".manifest"
+)
+
+// ErrNoActivities defines an error returned when an Assessment Plan
+// does not define any rule based activities.
+var ErrNoActivities = errors.New("no activities found in assessment plan")
+
+// Data defines the OPA data document for the rules in an Assessment Plan.
+type Data struct {
+	// Rules are the rule settings keyed by rule id.
+	Rules map[string]RuleData `json:"rules"`
+}
+
+// RuleData defines the settings for a single rule.
+type RuleData struct {
+	// Checks are the check ids implementing the rule.
+	Checks []string `json:"checks,omitempty"`
+	// Parameters are the selected parameter values keyed by parameter id. When a rule
+	// is tuned differently per control, the values from the first activity are used.
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// Controls are the selected parameter values for the rule in each control keyed
+	// by control id. The values for a control are read from the activity control selection
+	// with the control, so a rule tuned differently per control has different values.
+	Controls map[string]map[string]string `json:"controls,omitempty"`
+	// Waived is whether the rule is waived.
+	Waived bool `json:"waived,omitempty"`
+}
+
+type manifest struct {
+	Roots []string `json:"roots"`
+}
+
+// NewData returns the OPA data document for the activities in an Assessment Plan.
+//
+// The settings for each activity are resolved with `settings.NewAssessmentActivitiesSettings`, so skipped activities
+// are not included.
+func NewData(plan oscalTypes.AssessmentPlan) (Data, error) {
+	if plan.LocalDefinitions == nil || plan.LocalDefinitions.Activities == nil {
+		return Data{}, ErrNoActivities
+	}
+
+	data := Data{Rules: make(map[string]RuleData)}
+	seenChecks := make(map[string]set.Set[string])
+	for _, activity := range *plan.LocalDefinitions.Activities {
+		activitySettings := settings.NewAssessmentActivitiesSettings([]oscalTypes.Activity{activity})
+		if !activitySettings.ContainsRule(activity.Title) {
+			continue
+		}
+		parameters := activitySettings.SelectedParameters()
+
+		ruleData, ok := data.Rules[activity.Title]
+		if !ok {
+			ruleData.Parameters = parameters
+			seenChecks[activity.Title] = set.New[string]()
+		}
+		if activity.Steps != nil {
+			for _, step := range *activity.Steps {
+				if seenChecks[activity.Title].Has(step.Title) {
+					continue
+				}
+				seenChecks[activity.Title].Add(step.Title)
+				ruleData.Checks = append(ruleData.Checks, step.Title)
+			}
+		}
+		for _, group := range plans.ActivityParameters(activity) {
+			for _, controlId := range group.ControlIds {
+				if ruleData.Controls == nil {
+					ruleData.Controls = make(map[string]map[string]string)
+				}
+				ruleData.Controls[controlId] = parameterValues(group.Parameters)
+			}
+		}
+		waived, found := extensions.GetTrestleProp(extensions.WaivedRulesProperty, *activity.Props)
+		if found && waived.Value == "true" {
+			ruleData.Waived = true
+		}
+		data.Rules[activity.Title] = ruleData
+	}
+
+	if len(data.Rules) == 0 {
+		return Data{}, ErrNoActivities
+	}
+	return data, nil
+}
+
+// WriteBundle writes a gzipped OPA bundle with the data document under the given root and a manifest
+// claiming the root. If root is empty, the DefaultRoot is used. The bundle files have a fixed modification
+// time, so the same data always produces the same bundle.
+func WriteBundle(writer io.Writer, root string, data Data) error {
+	if root == "" {
+		root = DefaultRoot
+	}
+	root = strings.Trim(root, "/")
+
+	dataJSON, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal bundle data: %w", err)
+	}
+	manifestJSON, err := json.Marshal(manifest{Roots: []string{root}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal bundle manifest: %w", err)
+	}
+
+	gzipWriter := gzip.NewWriter(writer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	files := []struct {
+		name    string
+		content []byte
+	}{
+		{name: manifestFile, content: manifestJSON},
+		{name: path.Join(root, dataFile), content: dataJSON},
+	}
+	modTime := time.Unix(0, 0)
+	for _, file := range files {
+		header := &tar.Header{
+			Name:    file.name,
+			Mode:    0o644,
+			Size:    int64(len(file.content)),
+			ModTime: modTime,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return fmt.Errorf("failed to write bundle file %s: %w", file.name, err)
+		}
+		if _, err := tarWriter.Write(file.content); err != nil {
+			return fmt.Errorf("failed to write bundle file %s: %w", file.name, err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+	return gzipWriter.Close()
+}
+
+// parameterValues returns the parameter values keyed by parameter id.
+func parameterValues(parameters []extensions.Parameter) map[string]string {
+	values := make(map[string]string, len(parameters))
+	for _, parameter := range parameters {
+		values[parameter.ID] = parameter.Value
+	}
+	return values
+}
@@ -0,0 +1,225 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package opa
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/plans"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+	"github.com/oscal-compass/oscal-sdk-go/rules"
+	"github.com/oscal-compass/oscal-sdk-go/settings"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+func TestNewData(t *testing.T) {
+	file, err := os.Open("../testdata/test-ap.json")
+	require.NoError(t, err)
+	defer file.Close()
+	plan, err := models.NewAssessmentPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	data, err := NewData(*plan)
+	require.NoError(t, err)
+
+	expectedData := Data{
+		Rules: map[string]RuleData{
+			"rule-1": {
+				Checks:     []string{"check-1"},
+				Parameters: map[string]string{"param-1": ""},
+				Controls: map[string]map[string]string{
+					"ex-1": {"param-1": ""},
+					"ex-2": {"param-1": ""},
+				},
+			},
+			"rule-2": {
+				Parameters: map[string]string{},
+				Controls: map[string]map[string]string{
+					"ex-1": {},
+				},
+			},
+		},
+	}
+	require.Equal(t, expectedData, data)
+
+	_, err = NewData(oscalTypes.AssessmentPlan{})
+	require.ErrorIs(t, err, ErrNoActivities)
+}
+
+func TestNewData_WaivedAndSkippedActivities(t *testing.T) {
+	activity := func(controlId, value string, props ...oscalTypes.Property) oscalTypes.Activity {
+		allProps := append([]oscalTypes.Property{
+			{
+				Name:  "param-1",
+				Value: value,
+				Ns:    extensions.TrestleNameSpace,
+				Class: extensions.TestParameterClass,
+			},
+		}, props...)
+		return oscalTypes.Activity{
+			Title: "rule-1",
+			Props: &allProps,
+			Steps: &[]oscalTypes.Step{{Title: "check-1"}},
+			RelatedControls: &oscalTypes.ReviewedControls{
+				ControlSelections: []oscalTypes.AssessedControls{
+					{
+						IncludeControls: &[]oscalTypes.AssessedControlsSelectControlById{{ControlId: controlId}},
+					},
+				},
+			},
+		}
+	}
+	waived := oscalTypes.Property{
+		Name:  extensions.WaivedRulesProperty,
+		Value: "true",
+		Ns:    extensions.TrestleNameSpace,
+	}
+	skipped := oscalTypes.Property{
+		Name:  extensions.SkippedRulesProperty,
+		Value: "true",
+		Ns:    extensions.TrestleNameSpace,
+	}
+	plan := oscalTypes.AssessmentPlan{
+		LocalDefinitions: &oscalTypes.LocalDefinitions{
+			Activities: &[]oscalTypes.Activity{
+				activity("ex-1", "value-1"),
+				activity("ex-2", "value-2", waived),
+				activity("ex-3", "value-3", skipped),
+			},
+		},
+	}
+
+	data, err := NewData(plan)
+	require.NoError(t, err)
+	expectedRule := RuleData{
+		Checks:     []string{"check-1"},
+		Parameters: map[string]string{"param-1": "value-1"},
+		Controls: map[string]map[string]string{
+			"ex-1": {"param-1": "value-1"},
+			"ex-2": {"param-1": "value-2"},
+		},
+		Waived: true,
+	}
+	require.Equal(t, map[string]RuleData{"rule-1": expectedRule}, data.Rules)
+}
+
+func TestNewData_ControlParameters(t *testing.T) {
+	file, err := os.Open("../testdata/component-definition-test.json")
+	require.NoError(t, err)
+	defer file.Close()
+	definition, err := models.NewComponentDefinition(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	// Tune the etcd_key_file rule for a new control
+	targetComponent := (*definition.Components)[0]
+	implementation := (*targetComponent.ControlImplementations)[0]
+	implementation.ImplementedRequirements = append(implementation.ImplementedRequirements, oscalTypes.ImplementedRequirementControlImplementation{
+		ControlId: "CIS-2.2",
+		Props: &[]oscalTypes.Property{
+			{
+				Name:  extensions.RuleIdProp,
+				Value: "etcd_key_file",
+				Ns:    extensions.TrestleNameSpace,
+			},
+		},
+		SetParameters: &[]oscalTypes.SetParameter{
+			{
+				ParamId: "file_name",
+				Values:  []string{"control_override"},
+			},
+		},
+	})
+	implementationSettings, _, err := settings.ByFramework("cis", []oscalTypes.ControlImplementationSet{implementation})
+	require.NoError(t, err)
+
+	var comps []components.Component
+	for _, component := range *definition.Components {
+		comps = append(comps, components.NewDefinedComponentAdapter(component))
+	}
+	store := rules.NewMemoryStore()
+	require.NoError(t, store.IndexAll(comps))
+
+	plan, err := plans.GenerateAssessmentPlan(context.TODO(), comps, *implementationSettings, plans.WithRulesStore(store))
+	require.NoError(t, err)
+
+	// The rule has a single activity with a control selection per set of parameter values
+	var keyFileActivities []oscalTypes.Activity
+	for _, activity := range *plan.LocalDefinitions.Activities {
+		if activity.Title == "etcd_key_file" {
+			keyFileActivities = append(keyFileActivities, activity)
+		}
+	}
+	require.Len(t, keyFileActivities, 1)
+	require.Len(t, keyFileActivities[0].RelatedControls.ControlSelections, 2)
+
+	data, err := NewData(*plan)
+	require.NoError(t, err)
+	require.Contains(t, data.Rules, "etcd_key_file")
+	expectedRule := RuleData{
+		Checks:     []string{"etcd_key_file"},
+		Parameters: map[string]string{"file_name": "file_name_override"},
+		Controls: map[string]map[string]string{
+			"CIS-2.1": {"file_name": "file_name_override"},
+			"CIS-2.2": {"file_name": "control_override"},
+		},
+	}
+	require.Equal(t, expectedRule, data.Rules["etcd_key_file"])
+}
+
+func TestWriteBundle(t *testing.T) {
+	data := Data{
+		Rules: map[string]RuleData{
+			"rule-1": {
+				Checks:     []string{"check-1"},
+				Parameters: map[string]string{"param-1": "value-1"},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteBundle(&buf, "", data))
+
+	gzipReader, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	files := make(map[string][]byte)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.True(t, time.Unix(0, 0).Equal(header.ModTime))
+		content, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+		files[header.Name] = content
+	}
+
+	require.Len(t, files, 2)
+	require.JSONEq(t, `{"roots": ["oscal"]}`, string(files[".manifest"]))
+	var gotData Data
+	require.NoError(t, json.Unmarshal(files["oscal/data.json"], &gotData))
+	require.Equal(t, data, gotData)
+
+	// The bundle is reproducible
+	var first, second bytes.Buffer
+	require.NoError(t, WriteBundle(&first, "", data))
+	require.NoError(t, WriteBundle(&second, "", data))
+	require.Equal(t, first.Bytes(), second.Bytes())
+}
@@ -0,0 +1,8 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package opa defines logic for exporting OSCAL assessment settings as Open Policy Agent data documents
+// and bundles. OPA decision logs can be converted back to OSCAL Observations with the observations package.
+package opa
@@ -0,0 +1,133 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package posture
+
+import (
+	"sort"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+)
+
+// ChangeSet defines the changes in compliance posture between two Assessment Results.
+type ChangeSet struct {
+	// NewlyFailing are the checks that fail and did not fail previously.
+	NewlyFailing []CheckChange `json:"newlyFailing,omitempty"`
+	// Fixed are the checks that failed previously and now pass.
+	Fixed []CheckChange `json:"fixed,omitempty"`
+	// Changed are the checks with any other change in result.
+	Changed []CheckChange `json:"changed,omitempty"`
+	// AddedChecks are the checks only in the current Assessment Results.
+	AddedChecks []CheckKey `json:"addedChecks,omitempty"`
+	// RemovedChecks are the checks only in the previous Assessment Results.
+	RemovedChecks []CheckKey `json:"removedChecks,omitempty"`
+	// AddedSubjects are the subjects only assessed by a check in the current Assessment Results.
+	AddedSubjects []SubjectChange `json:"addedSubjects,omitempty"`
+	// RemovedSubjects are the subjects only assessed by a check in the previous Assessment Results.
+	RemovedSubjects []SubjectChange `json:"removedSubjects,omitempty"`
+	// AddedControls are the reviewed controls only in the current Assessment Results.
+	AddedControls []string `json:"addedControls,omitempty"`
+	// RemovedControls are the reviewed controls only in the previous Assessment Results.
+	RemovedControls []string `json:"removedControls,omitempty"`
+}
+
+// CheckChange defines a change in the result of a check.
+type CheckChange struct {
+	CheckKey
+	// Previous is the previous result. It is empty if the check had no result.
+	Previous extensions.Result `json:"previous,omitempty"`
+	// Current is the current result. It is empty if the check has no result.
+	Current extensions.Result `json:"current,omitempty"`
+}
+
+// SubjectChange defines a subject added or removed for a check.
+type SubjectChange struct {
+	CheckKey
+	// SubjectUUID is the UUID of the subject.
+	SubjectUUID string `json:"subjectUuid"`
+	// Title is the title of the subject, if set.
+	Title string `json:"title,omitempty"`
+}
+
+// IsEmpty returns whether there are no changes in the ChangeSet.
+func (c ChangeSet) IsEmpty() bool {
+	return len(c.NewlyFailing) == 0 && len(c.Fixed) == 0 && len(c.Changed) == 0 &&
+		len(c.AddedChecks) == 0 && len(c.RemovedChecks) == 0 &&
+		len(c.AddedSubjects) == 0 && len(c.RemovedSubjects) == 0 &&
+		len(c.AddedControls) == 0 && len(c.RemovedControls) == 0
+}
+
+// Diff returns the changes in compliance posture from the previous to the current Assessment Results.
+//
+// Checks are matched by the assessment-rule-id and assessment-check-id observation properties. When a check has several
+// observations, the results are aggregated and the subjects combined. A new check that fails is reported as both an added
+// check and newly failing.
+func Diff(previous, current oscalTypes.AssessmentResults) ChangeSet {
+	previousIndex := newCheckIndex(previous)
+	currentIndex := newCheckIndex(current)
+
+	var changes ChangeSet
+	for _, key := range currentIndex.keys() {
+		currentState := currentIndex.checks[key]
+		previousState, existed := previousIndex.checks[key]
+		if !existed {
+			changes.AddedChecks = append(changes.AddedChecks, key)
+			if currentState.result == extensions.ResultFail {
+				changes.NewlyFailing = append(changes.NewlyFailing, CheckChange{CheckKey: key, Current: currentState.result})
+			}
+			continue
+		}
+
+		change := CheckChange{CheckKey: key, Previous: previousState.result, Current: currentState.result}
+		switch {
+		case change.Previous == change.Current:
+		case change.Current == extensions.ResultFail:
+			changes.NewlyFailing = append(changes.NewlyFailing, change)
+		case change.Previous == extensions.ResultFail && change.Current == extensions.ResultPass:
+			changes.Fixed = append(changes.Fixed, change)
+		default:
+			changes.Changed = append(changes.Changed, change)
+		}
+
+		changes.AddedSubjects = append(changes.AddedSubjects, subjectDifference(key, currentState, previousState)...)
+		changes.RemovedSubjects = append(changes.RemovedSubjects, subjectDifference(key, previousState, currentState)...)
+	}
+	for _, key := range previousIndex.keys() {
+		if _, exists := currentIndex.checks[key]; !exists {
+			changes.RemovedChecks = append(changes.RemovedChecks, key)
+		}
+	}
+
+	for controlId := range currentIndex.controls {
+		if !previousIndex.controls.Has(controlId) {
+			changes.AddedControls = append(changes.AddedControls, controlId)
+		}
+	}
+	for controlId := range previousIndex.controls {
+		if !currentIndex.controls.Has(controlId) {
+			changes.RemovedControls = append(changes.RemovedControls, controlId)
+		}
+	}
+	sort.Strings(changes.AddedControls)
+	sort.Strings(changes.RemovedControls)
+	return changes
+}
+
+// subjectDifference returns the subjects in a check state that are not in the other check state.
+func subjectDifference(key CheckKey, state, other *checkState) []SubjectChange {
+	var changes []SubjectChange
+	for subjectUUID, subject := range state.subjects {
+		if _, ok := other.subjects[subjectUUID]; ok {
+			continue
+		}
+		changes = append(changes, SubjectChange{CheckKey: key, SubjectUUID: subjectUUID, Title: subject.Title})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].SubjectUUID < changes[j].SubjectUUID
+	})
+	return changes
+}
@@ -0,0 +1,156 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package posture
+
+import (
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+)
+
+func TestDiff(t *testing.T) {
+	previous := testAssessmentResults([]string{"ac-1", "ac-2"},
+		testObservation("rule-1", "check-1", extensions.ResultFail, "subject-1"),
+		testObservation("rule-2", "check-2", extensions.ResultPass, "subject-1"),
+		testObservation("rule-3", "check-3", extensions.ResultPass, "subject-1", "subject-2"),
+		testObservation("rule-4", "check-4", extensions.ResultError, "subject-1"),
+		testObservation("rule-5", "check-5", extensions.ResultPass, "subject-1"),
+	)
+	current := testAssessmentResults([]string{"ac-2", "ac-3"},
+		testObservation("rule-1", "check-1", extensions.ResultPass, "subject-1"),
+		testObservation("rule-2", "check-2", extensions.ResultFail, "subject-1"),
+		testObservation("rule-3", "check-3", extensions.ResultPass, "subject-2", "subject-3"),
+		testObservation("rule-4", "check-4", extensions.ResultPass, "subject-1"),
+		testObservation("rule-6", "check-6", extensions.ResultFail, "subject-1"),
+	)
+
+	tests := []struct {
+		name          string
+		previous      oscalTypes.AssessmentResults
+		current       oscalTypes.AssessmentResults
+		wantChangeSet ChangeSet
+		wantEmpty     bool
+	}{
+		{
+			name:          "Valid/NoChanges",
+			previous:      previous,
+			current:       previous,
+			wantChangeSet: ChangeSet{},
+			wantEmpty:     true,
+		},
+		{
+			name:     "Valid/Changes",
+			previous: previous,
+			current:  current,
+			wantChangeSet: ChangeSet{
+				NewlyFailing: []CheckChange{
+					{
+						CheckKey: CheckKey{RuleID: "rule-2", CheckID: "check-2"},
+						Previous: extensions.ResultPass,
+						Current:  extensions.ResultFail,
+					},
+					{
+						CheckKey: CheckKey{RuleID: "rule-6", CheckID: "check-6"},
+						Current:  extensions.ResultFail,
+					},
+				},
+				Fixed: []CheckChange{
+					{
+						CheckKey: CheckKey{RuleID: "rule-1", CheckID: "check-1"},
+						Previous: extensions.ResultFail,
+						Current:  extensions.ResultPass,
+					},
+				},
+				Changed: []CheckChange{
+					{
+						CheckKey: CheckKey{RuleID: "rule-4", CheckID: "check-4"},
+						Previous: extensions.ResultError,
+						Current:  extensions.ResultPass,
+					},
+				},
+				AddedChecks:   []CheckKey{{RuleID: "rule-6", CheckID: "check-6"}},
+				RemovedChecks: []CheckKey{{RuleID: "rule-5", CheckID: "check-5"}},
+				AddedSubjects: []SubjectChange{
+					{
+						CheckKey:    CheckKey{RuleID: "rule-3", CheckID: "check-3"},
+						SubjectUUID: "subject-3",
+						Title:       "Subject subject-3",
+					},
+				},
+				RemovedSubjects: []SubjectChange{
+					{
+						CheckKey:    CheckKey{RuleID: "rule-3", CheckID: "check-3"},
+						SubjectUUID: "subject-1",
+						Title:       "Subject subject-1",
+					},
+				},
+				AddedControls:   []string{"ac-3"},
+				RemovedControls: []string{"ac-1"},
+			},
+		},
+		{
+			name:     "Valid/FromEmpty",
+			previous: oscalTypes.AssessmentResults{},
+			current: testAssessmentResults([]string{"ac-1"},
+				testObservation("rule-1", "check-1", extensions.ResultPass, "subject-1"),
+			),
+			wantChangeSet: ChangeSet{
+				AddedChecks:   []CheckKey{{RuleID: "rule-1", CheckID: "check-1"}},
+				AddedControls: []string{"ac-1"},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			changes := Diff(c.previous, c.current)
+			require.Equal(t, c.wantChangeSet, changes)
+			require.Equal(t, c.wantEmpty, changes.IsEmpty())
+		})
+	}
+}
+
+func testAssessmentResults(controlIds []string, observations ...oscalTypes.Observation) oscalTypes.AssessmentResults {
+	var controls []oscalTypes.AssessedControlsSelectControlById
+	for _, controlId := range controlIds {
+		controls = append(controls, oscalTypes.AssessedControlsSelectControlById{ControlId: controlId})
+	}
+	return oscalTypes.AssessmentResults{
+		Results: []oscalTypes.Result{
+			{
+				ReviewedControls: oscalTypes.ReviewedControls{
+					ControlSelections: []oscalTypes.AssessedControls{
+						{IncludeControls: &controls},
+					},
+				},
+				Observations: &observations,
+			},
+		},
+	}
+}
+
+func testObservation(ruleId, checkId string, result extensions.Result, subjectUUIDs ...string) oscalTypes.Observation {
+	var subjects []oscalTypes.SubjectReference
+	for _, subjectUUID := range subjectUUIDs {
+		subjects = append(subjects, oscalTypes.SubjectReference{
+			SubjectUuid: subjectUUID,
+			Title:       "Subject " + subjectUUID,
+			Type:        "component",
+		})
+	}
+	return oscalTypes.Observation{
+		Title: checkId,
+		Props: &[]oscalTypes.Property{
+			{Name: extensions.AssessmentRuleIdProp, Ns: extensions.TrestleNameSpace, Value: ruleId},
+			{Name: extensions.AssessmentCheckIdProp, Ns: extensions.TrestleNameSpace, Value: checkId},
+			extensions.NewResultProp(result),
+		},
+		Subjects: &subjects,
+	}
+}
@@ -0,0 +1,7 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package posture defines logic for evaluating compliance posture from OSCAL Assessment Results.
+package posture
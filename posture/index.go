@@ -0,0 +1,132 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package posture
+
+import (
+	"sort"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+)
+
+// CheckKey identifies a check in Assessment Results by the assessment-rule-id
+// and assessment-check-id properties.
+type CheckKey struct {
+	// RuleID is the rule the check implements.
+	RuleID string `json:"ruleId,omitempty"`
+	// CheckID is the check identifier.
+	CheckID string `json:"checkId"`
+}
+
+// checkState is the aggregated state of a check across all observations.
+type checkState struct {
+	key          CheckKey
+	result       extensions.Result
+	hasResult    bool
+	subjects     map[string]oscalTypes.SubjectReference
+	observations []oscalTypes.Observation
+}
+
+// checkIndex indexes the observations in Assessment Results by check.
+type checkIndex struct {
+	checks   map[CheckKey]*checkState
+	controls set.Set[string]
+}
+
+// newCheckIndex returns a checkIndex for all result entries in the Assessment Results.
+func newCheckIndex(assessmentResults oscalTypes.AssessmentResults) *checkIndex {
+	index := &checkIndex{
+		checks:   make(map[CheckKey]*checkState),
+		controls: set.New[string](),
+	}
+	for _, result := range assessmentResults.Results {
+		for _, controlId := range reviewedControlIds(result.ReviewedControls) {
+			index.controls.Add(controlId)
+		}
+		if result.Observations == nil {
+			continue
+		}
+		for _, observation := range *result.Observations {
+			key, ok := checkKey(observation)
+			if !ok {
+				continue
+			}
+			state, ok := index.checks[key]
+			if !ok {
+				state = &checkState{
+					key:      key,
+					subjects: make(map[string]oscalTypes.SubjectReference),
+				}
+				index.checks[key] = state
+			}
+			state.observations = append(state.observations, observation)
+			if result, found := results.ObservationResult(observation); found {
+				if state.hasResult {
+					result = results.AggregateResults(state.result, result)
+				}
+				state.result = result
+				state.hasResult = true
+			}
+			if observation.Subjects != nil {
+				for _, subject := range *observation.Subjects {
+					state.subjects[subject.SubjectUuid] = subject
+				}
+			}
+		}
+	}
+	return index
+}
+
+// keys returns the sorted check keys in the index.
+func (c *checkIndex) keys() []CheckKey {
+	keys := make([]CheckKey, 0, len(c.checks))
+	for key := range c.checks {
+		keys = append(keys, key)
+	}
+	sortKeys(keys)
+	return keys
+}
+
+// checkKey returns the CheckKey for an observation. The observation title is used
+// when the assessment-check-id property is not set.
+func checkKey(observation oscalTypes.Observation) (CheckKey, bool) {
+	key := CheckKey{CheckID: observation.Title}
+	if observation.Props != nil {
+		if rule, found := extensions.GetTrestleProp(extensions.AssessmentRuleIdProp, *observation.Props); found {
+			key.RuleID = rule.Value
+		}
+		if check, found := extensions.GetTrestleProp(extensions.AssessmentCheckIdProp, *observation.Props); found {
+			key.CheckID = check.Value
+		}
+	}
+	return key, key.CheckID != ""
+}
+
+// reviewedControlIds returns the ids of all included controls.
+func reviewedControlIds(reviewed oscalTypes.ReviewedControls) []string {
+	var controlIds []string
+	for _, selection := range reviewed.ControlSelections {
+		if selection.IncludeControls == nil {
+			continue
+		}
+		for _, control := range *selection.IncludeControls {
+			controlIds = append(controlIds, control.ControlId)
+		}
+	}
+	return controlIds
+}
+
+func sortKeys(keys []CheckKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].RuleID != keys[j].RuleID {
+			return keys[i].RuleID < keys[j].RuleID
+		}
+		return keys[i].CheckID < keys[j].CheckID
+	})
+}
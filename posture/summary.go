@@ -0,0 +1,407 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package posture
+
+import (
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+)
+
+// status is the outcome of a check used for counting.
+type status string
+
+const (
+	statusWaived   status = "waived"
+	statusSkipped  status = "skipped"
+	statusNoResult status = "no-result"
+
+	// controlSourceRel is the relation of the reviewed controls link to the control source.
+	controlSourceRel = "includes-controls-from-source"
+)
+
+// WeightFunc returns the weight of a check when calculating scores.
+type WeightFunc func(key CheckKey) float64
+
+// EqualWeight is the default WeightFunc where all checks have the same weight.
+func EqualWeight(_ CheckKey) float64 {
+	return 1
+}
+
+// FamilyFunc returns the control family for a control id.
+type FamilyFunc func(controlId string) string
+
+// ControlFamily is the default FamilyFunc where the family is the control id prefix before the first "-",
+// such as "ac" for "ac-2.1". Use `WithCatalog` or `WithFamilyFunc` for control ids in other formats.
+func ControlFamily(controlId string) string {
+	family, _, _ := strings.Cut(controlId, "-")
+	return family
+}
+
+type summaryOpts struct {
+	activities       []oscalTypes.Activity
+	frameworksByRule map[string]set.Set[string]
+	weight           WeightFunc
+	family           FamilyFunc
+}
+
+func (s *summaryOpts) defaults() {
+	s.weight = EqualWeight
+	s.family = ControlFamily
+	s.frameworksByRule = make(map[string]set.Set[string])
+}
+
+// SummaryOption defines an option to tune the behavior of the Summarize function.
+type SummaryOption func(opts *summaryOpts)
+
+// WithAssessmentPlan is a SummaryOption that adds the activities from the Assessment Plan
+// used to generate the Assessment Results. The rules in the activities are counted for the framework
+// linked from the plan reviewed controls.
+func WithAssessmentPlan(plan oscalTypes.AssessmentPlan) SummaryOption {
+	return func(opts *summaryOpts) {
+		if plan.LocalDefinitions == nil || plan.LocalDefinitions.Activities == nil {
+			return
+		}
+		opts.activities = append(opts.activities, *plan.LocalDefinitions.Activities...)
+		framework := controlSource(plan.ReviewedControls, plan.BackMatter)
+		if framework == "" {
+			return
+		}
+		for _, activity := range *plan.LocalDefinitions.Activities {
+			frameworks, ok := opts.frameworksByRule[activity.Title]
+			if !ok {
+				frameworks = set.New[string]()
+				opts.frameworksByRule[activity.Title] = frameworks
+			}
+			frameworks.Add(framework)
+		}
+	}
+}
+
+// WithFamilyFunc is a SummaryOption that sets how the control family is derived from a control id.
+func WithFamilyFunc(family FamilyFunc) SummaryOption {
+	return func(opts *summaryOpts) {
+		opts.family = family
+	}
+}
+
+// WithCatalog is a SummaryOption that sets the control family to the top-level group of the
+// control in the Catalog. Controls that are not in a group use the default ControlFamily.
+func WithCatalog(catalog oscalTypes.Catalog) SummaryOption {
+	return func(opts *summaryOpts) {
+		familyByControl := make(map[string]string)
+		if catalog.Groups != nil {
+			for _, group := range *catalog.Groups {
+				addGroupFamily(familyByControl, group, group.ID)
+			}
+		}
+		opts.family = func(controlId string) string {
+			if family, ok := familyByControl[controlId]; ok {
+				return family
+			}
+			return ControlFamily(controlId)
+		}
+	}
+}
+
+// WithWeightFunc is a SummaryOption that sets the weight of each check in the scores.
+func WithWeightFunc(weight WeightFunc) SummaryOption {
+	return func(opts *summaryOpts) {
+		opts.weight = weight
+	}
+}
+
+// Counts defines the number of checks by outcome and the resulting score.
+type Counts struct {
+	Pass          int `json:"pass"`
+	Fail          int `json:"fail"`
+	Error         int `json:"error"`
+	NotApplicable int `json:"notApplicable"`
+	Waived        int `json:"waived"`
+	Skipped       int `json:"skipped"`
+	NoResult      int `json:"noResult"`
+	// Weight is the total weight of the scored checks. Passing, failing, errored checks
+	// and checks without a result are scored.
+	Weight float64 `json:"weight"`
+	// Score is the weighted ratio of passing checks to scored checks, between 0 and 1.
+	// The score is 0 when no checks are scored.
+	Score float64 `json:"score"`
+
+	passWeight float64
+}
+
+// SubjectSummary defines the counts for an Observation subject.
+type SubjectSummary struct {
+	Title string `json:"title,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Counts
+}
+
+// Summary defines the compliance posture summary for Assessment Results.
+type Summary struct {
+	// Total are the counts for all checks.
+	Total Counts `json:"total"`
+	// Rules are the counts by rule id.
+	Rules map[string]Counts `json:"rules,omitempty"`
+	// Controls are the counts by control id.
+	Controls map[string]Counts `json:"controls,omitempty"`
+	// Frameworks are the counts by framework. The framework is the control source linked from
+	// the Assessment Plan reviewed controls, or the Assessment Results import-ap href.
+	Frameworks map[string]Counts `json:"frameworks,omitempty"`
+	// Families are the counts by control family. By default, the family is the control id
+	// prefix before the first "-".
+	Families map[string]Counts `json:"families,omitempty"`
+	// Subjects are the counts by Observation subject UUID, with each subject
+	// counted per check.
+	Subjects map[string]SubjectSummary `json:"subjects,omitempty"`
+}
+
+// Summarize returns a Summary of the checks in the Assessment Results.
+//
+// Rules are mapped to controls through the related controls of the activity with the rule as the title. Checks without
+// the assessment-rule-id property are mapped to the rule of the activity with the check as a step. Activities are
+// read from the Assessment Results local definitions and from the Assessment Plan set with `WithAssessmentPlan`. All
+// controls in the result reviewed controls are included in the Summary, even without checks. Checks are skipped when
+// the activity is skipped and waived when the activity or all subjects are waived.
+//
+// Checks are counted by framework using the control source of the Assessment Plan with the rule, so plans for several
+// frameworks can be summarized together. Checks for rules without a known control source are counted for the Assessment
+// Results import-ap href.
+func Summarize(assessmentResults oscalTypes.AssessmentResults, opts ...SummaryOption) Summary {
+	options := summaryOpts{}
+	options.defaults()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	activities := options.activities
+	if assessmentResults.LocalDefinitions != nil && assessmentResults.LocalDefinitions.Activities != nil {
+		activities = append(activities, *assessmentResults.LocalDefinitions.Activities...)
+	}
+	controlsByRule := make(map[string]set.Set[string])
+	rulesByCheck := make(map[string]string)
+	waivedRules := set.New[string]()
+	skippedRules := set.New[string]()
+	for _, activity := range activities {
+		if activity.Props != nil {
+			if skipped, found := extensions.GetTrestleProp(extensions.SkippedRulesProperty, *activity.Props); found && skipped.Value == "true" {
+				skippedRules.Add(activity.Title)
+			}
+			if waived, found := extensions.GetTrestleProp(extensions.WaivedRulesProperty, *activity.Props); found && waived.Value == "true" {
+				waivedRules.Add(activity.Title)
+			}
+		}
+		if activity.Steps != nil {
+			for _, step := range *activity.Steps {
+				rulesByCheck[step.Title] = activity.Title
+			}
+		}
+		if activity.RelatedControls == nil {
+			continue
+		}
+		controls, ok := controlsByRule[activity.Title]
+		if !ok {
+			controls = set.New[string]()
+			controlsByRule[activity.Title] = controls
+		}
+		for _, controlId := range reviewedControlIds(*activity.RelatedControls) {
+			controls.Add(controlId)
+		}
+	}
+
+	index := newCheckIndex(assessmentResults)
+	summary := Summary{
+		Rules:      make(map[string]Counts),
+		Controls:   make(map[string]Counts),
+		Frameworks: make(map[string]Counts),
+		Families:   make(map[string]Counts),
+		Subjects:   make(map[string]SubjectSummary),
+	}
+	for controlId := range index.controls {
+		summary.Controls[controlId] = Counts{}
+		summary.Families[options.family(controlId)] = Counts{}
+	}
+
+	for _, key := range index.keys() {
+		state := index.checks[key]
+		weight := options.weight(key)
+		ruleId := key.RuleID
+		if ruleId == "" {
+			ruleId = rulesByCheck[key.CheckID]
+		}
+		checkStatus := statusForCheck(state, skippedRules.Has(ruleId), waivedRules.Has(ruleId))
+
+		summary.Total.add(checkStatus, weight)
+		if ruleId != "" {
+			addCounts(summary.Rules, ruleId, checkStatus, weight)
+		}
+		if frameworks, ok := options.frameworksByRule[ruleId]; ok {
+			for framework := range frameworks {
+				addCounts(summary.Frameworks, framework, checkStatus, weight)
+			}
+		} else if assessmentResults.ImportAp.Href != "" {
+			addCounts(summary.Frameworks, assessmentResults.ImportAp.Href, checkStatus, weight)
+		}
+		families := set.New[string]()
+		for controlId := range controlsByRule[ruleId] {
+			addCounts(summary.Controls, controlId, checkStatus, weight)
+			families.Add(options.family(controlId))
+		}
+		for family := range families {
+			addCounts(summary.Families, family, checkStatus, weight)
+		}
+
+		for subjectUUID, subject := range state.subjects {
+			subjectStatus := checkStatus
+			if checkStatus != statusSkipped && checkStatus != statusWaived {
+				subjectStatus = statusForSubject(subject, state)
+			}
+			subjectSummary, ok := summary.Subjects[subjectUUID]
+			if !ok {
+				subjectSummary = SubjectSummary{Title: subject.Title, Type: subject.Type}
+			}
+			subjectSummary.add(subjectStatus, weight)
+			summary.Subjects[subjectUUID] = subjectSummary
+		}
+	}
+	return summary
+}
+
+// add counts a check with the given status and weight.
+func (c *Counts) add(checkStatus status, weight float64) {
+	switch checkStatus {
+	case status(extensions.ResultPass):
+		c.Pass++
+		c.passWeight += weight
+	case status(extensions.ResultFail):
+		c.Fail++
+	case status(extensions.ResultError):
+		c.Error++
+	case status(extensions.ResultNotApplicable):
+		c.NotApplicable++
+		return
+	case statusWaived:
+		c.Waived++
+		return
+	case statusSkipped:
+		c.Skipped++
+		return
+	default:
+		c.NoResult++
+	}
+	c.Weight += weight
+	if c.Weight > 0 {
+		c.Score = c.passWeight / c.Weight
+	}
+}
+
+func addCounts(counts map[string]Counts, name string, checkStatus status, weight float64) {
+	updated := counts[name]
+	updated.add(checkStatus, weight)
+	counts[name] = updated
+}
+
+// statusForCheck returns the status of a check from the aggregated result of all observations.
+func statusForCheck(state *checkState, skipped, waived bool) status {
+	switch {
+	case skipped:
+		return statusSkipped
+	case waived || allSubjectsWaived(state):
+		return statusWaived
+	case !state.hasResult:
+		return statusNoResult
+	default:
+		return status(state.result)
+	}
+}
+
+// statusForSubject returns the status of a subject for a check. The check result is used
+// when the subject has no result.
+func statusForSubject(subject oscalTypes.SubjectReference, state *checkState) status {
+	if isWaived(subject) {
+		return statusWaived
+	}
+	if result, found := results.SubjectResult(subject); found {
+		return status(result)
+	}
+	if !state.hasResult {
+		return statusNoResult
+	}
+	return status(state.result)
+}
+
+func allSubjectsWaived(state *checkState) bool {
+	if len(state.subjects) == 0 {
+		return false
+	}
+	for _, subject := range state.subjects {
+		if !isWaived(subject) {
+			return false
+		}
+	}
+	return true
+}
+
+func isWaived(subject oscalTypes.SubjectReference) bool {
+	if subject.Props == nil {
+		return false
+	}
+	waived, found := extensions.GetTrestleProp(extensions.WaivedRulesProperty, *subject.Props)
+	return found && waived.Value == "true"
+}
+
+// addGroupFamily maps all controls in a group and its subgroups to the family.
+func addGroupFamily(familyByControl map[string]string, group oscalTypes.Group, family string) {
+	if group.Controls != nil {
+		for _, control := range *group.Controls {
+			addControlFamily(familyByControl, control, family)
+		}
+	}
+	if group.Groups != nil {
+		for _, subgroup := range *group.Groups {
+			addGroupFamily(familyByControl, subgroup, family)
+		}
+	}
+}
+
+// addControlFamily maps a control and its enhancements to the family.
+func addControlFamily(familyByControl map[string]string, control oscalTypes.Control, family string) {
+	familyByControl[control.ID] = family
+	if control.Controls != nil {
+		for _, enhancement := range *control.Controls {
+			addControlFamily(familyByControl, enhancement, family)
+		}
+	}
+}
+
+// controlSource returns the href of the control source linked from the reviewed controls. Links
+// to a back-matter resource are resolved to the resource link href.
+func controlSource(reviewed oscalTypes.ReviewedControls, backMatter *oscalTypes.BackMatter) string {
+	if reviewed.Links == nil {
+		return ""
+	}
+	for _, link := range *reviewed.Links {
+		if link.Rel != controlSourceRel {
+			continue
+		}
+		if !strings.HasPrefix(link.Href, "#") {
+			return link.Href
+		}
+		if backMatter == nil {
+			continue
+		}
+		resource, found := models.FindResource(*backMatter, link.Href)
+		if found && resource.Rlinks != nil && len(*resource.Rlinks) > 0 {
+			return (*resource.Rlinks)[0].Href
+		}
+	}
+	return ""
+}
@@ -0,0 +1,234 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package posture
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+)
+
+func TestSummarize(t *testing.T) {
+	waivedSubject := testObservation("rule-4", "check-4", extensions.ResultFail, "subject-2")
+	(*waivedSubject.Subjects)[0].Props = &[]oscalTypes.Property{
+		{Name: extensions.WaivedRulesProperty, Ns: extensions.TrestleNameSpace, Value: "true"},
+	}
+	assessmentResults := testAssessmentResults([]string{"ac-1", "ac-2", "au-1"},
+		testObservation("rule-1", "check-1", extensions.ResultPass, "subject-1"),
+		testObservation("rule-1", "check-2", extensions.ResultFail, "subject-1"),
+		testObservation("rule-2", "check-3", extensions.ResultPass, "subject-1"),
+		waivedSubject,
+		testObservation("rule-5", "check-5", extensions.ResultFail, "subject-1"),
+	)
+	assessmentResults.ImportAp.Href = "ap.json"
+	plan := oscalTypes.AssessmentPlan{
+		ReviewedControls: oscalTypes.ReviewedControls{
+			Links: &[]oscalTypes.Link{{Href: "#11111111-1111-4111-8111-111111111111", Rel: "includes-controls-from-source"}},
+		},
+		BackMatter: &oscalTypes.BackMatter{
+			Resources: &[]oscalTypes.Resource{
+				{
+					UUID:   "11111111-1111-4111-8111-111111111111",
+					Rlinks: &[]oscalTypes.ResourceLink{{Href: "profiles/nist/profile.json"}},
+				},
+			},
+		},
+		LocalDefinitions: &oscalTypes.LocalDefinitions{
+			Activities: &[]oscalTypes.Activity{
+				testActivity("rule-1", "ac-1", "ac-2"),
+				testActivity("rule-2", "ac-2"),
+				testActivity("rule-4", "ac-2"),
+			},
+		},
+	}
+	skipped := testActivity("rule-5", "au-1")
+	skipped.Props = &[]oscalTypes.Property{
+		{Name: extensions.SkippedRulesProperty, Ns: extensions.TrestleNameSpace, Value: "true"},
+	}
+	assessmentResults.LocalDefinitions = &oscalTypes.LocalDefinitions{
+		Activities: &[]oscalTypes.Activity{skipped},
+	}
+
+	tests := []struct {
+		name        string
+		options     []SummaryOption
+		wantSummary Summary
+	}{
+		{
+			name:    "Valid/WithAssessmentPlan",
+			options: []SummaryOption{WithAssessmentPlan(plan)},
+			wantSummary: Summary{
+				Total: Counts{Pass: 2, Fail: 1, Waived: 1, Skipped: 1, Weight: 3, Score: 2.0 / 3, passWeight: 2},
+				Rules: map[string]Counts{
+					"rule-1": {Pass: 1, Fail: 1, Weight: 2, Score: 0.5, passWeight: 1},
+					"rule-2": {Pass: 1, Weight: 1, Score: 1, passWeight: 1},
+					"rule-4": {Waived: 1},
+					"rule-5": {Skipped: 1},
+				},
+				Controls: map[string]Counts{
+					"ac-1": {Pass: 1, Fail: 1, Weight: 2, Score: 0.5, passWeight: 1},
+					"ac-2": {Pass: 2, Fail: 1, Waived: 1, Weight: 3, Score: 2.0 / 3, passWeight: 2},
+					"au-1": {Skipped: 1},
+				},
+				Frameworks: map[string]Counts{
+					"profiles/nist/profile.json": {Pass: 2, Fail: 1, Waived: 1, Weight: 3, Score: 2.0 / 3, passWeight: 2},
+					"ap.json":                    {Skipped: 1},
+				},
+				Families: map[string]Counts{
+					"ac": {Pass: 2, Fail: 1, Waived: 1, Weight: 3, Score: 2.0 / 3, passWeight: 2},
+					"au": {Skipped: 1},
+				},
+				Subjects: map[string]SubjectSummary{
+					"subject-1": {
+						Title:  "Subject subject-1",
+						Type:   "component",
+						Counts: Counts{Pass: 2, Fail: 1, Skipped: 1, Weight: 3, Score: 2.0 / 3, passWeight: 2},
+					},
+					"subject-2": {
+						Title:  "Subject subject-2",
+						Type:   "component",
+						Counts: Counts{Waived: 1},
+					},
+				},
+			},
+		},
+		{
+			name: "Valid/WithWeightFunc",
+			options: []SummaryOption{
+				WithWeightFunc(func(key CheckKey) float64 {
+					if key.CheckID == "check-2" {
+						return 3
+					}
+					return 1
+				}),
+			},
+			wantSummary: Summary{
+				Total: Counts{Pass: 2, Fail: 1, Waived: 1, Skipped: 1, Weight: 5, Score: 0.4, passWeight: 2},
+				Rules: map[string]Counts{
+					"rule-1": {Pass: 1, Fail: 1, Weight: 4, Score: 0.25, passWeight: 1},
+					"rule-2": {Pass: 1, Weight: 1, Score: 1, passWeight: 1},
+					"rule-4": {Waived: 1},
+					"rule-5": {Skipped: 1},
+				},
+				Controls: map[string]Counts{
+					"ac-1": {},
+					"ac-2": {},
+					"au-1": {Skipped: 1},
+				},
+				Frameworks: map[string]Counts{
+					"ap.json": {Pass: 2, Fail: 1, Waived: 1, Skipped: 1, Weight: 5, Score: 0.4, passWeight: 2},
+				},
+				Families: map[string]Counts{
+					"ac": {},
+					"au": {Skipped: 1},
+				},
+				Subjects: map[string]SubjectSummary{
+					"subject-1": {
+						Title:  "Subject subject-1",
+						Type:   "component",
+						Counts: Counts{Pass: 2, Fail: 1, Skipped: 1, Weight: 5, Score: 0.4, passWeight: 2},
+					},
+					"subject-2": {
+						Title:  "Subject subject-2",
+						Type:   "component",
+						Counts: Counts{Waived: 1},
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			summary := Summarize(assessmentResults, c.options...)
+			require.Equal(t, c.wantSummary, summary)
+
+			_, err := json.Marshal(summary)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSummarize_Families(t *testing.T) {
+	assessmentResults := testAssessmentResults([]string{"CIS-1.1", "CIS-2.1"},
+		testObservation("rule-1", "check-1", extensions.ResultPass, "subject-1"),
+		testObservation("rule-2", "check-2", extensions.ResultFail, "subject-1"),
+	)
+	assessmentResults.LocalDefinitions = &oscalTypes.LocalDefinitions{
+		Activities: &[]oscalTypes.Activity{
+			testActivity("rule-1", "CIS-1.1"),
+			testActivity("rule-2", "CIS-2.1"),
+		},
+	}
+	catalog := oscalTypes.Catalog{
+		Groups: &[]oscalTypes.Group{
+			{ID: "section-1", Controls: &[]oscalTypes.Control{{ID: "CIS-1"}, {ID: "CIS-1.1"}}},
+			{
+				ID:     "section-2",
+				Groups: &[]oscalTypes.Group{{ID: "section-2.1", Controls: &[]oscalTypes.Control{{ID: "CIS-2", Controls: &[]oscalTypes.Control{{ID: "CIS-2.1"}}}}}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		options      []SummaryOption
+		wantFamilies map[string]Counts
+	}{
+		{
+			name: "Valid/Default",
+			wantFamilies: map[string]Counts{
+				"CIS": {Pass: 1, Fail: 1, Weight: 2, Score: 0.5, passWeight: 1},
+			},
+		},
+		{
+			name:    "Valid/WithCatalog",
+			options: []SummaryOption{WithCatalog(catalog)},
+			wantFamilies: map[string]Counts{
+				"section-1": {Pass: 1, Weight: 1, Score: 1, passWeight: 1},
+				"section-2": {Fail: 1, Weight: 1},
+			},
+		},
+		{
+			name: "Valid/WithFamilyFunc",
+			options: []SummaryOption{WithFamilyFunc(func(controlId string) string {
+				family, _, _ := strings.Cut(controlId, ".")
+				return family
+			})},
+			wantFamilies: map[string]Counts{
+				"CIS-1": {Pass: 1, Weight: 1, Score: 1, passWeight: 1},
+				"CIS-2": {Fail: 1, Weight: 1},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			summary := Summarize(assessmentResults, c.options...)
+			require.Equal(t, c.wantFamilies, summary.Families)
+		})
+	}
+}
+
+func testActivity(ruleId string, controlIds ...string) oscalTypes.Activity {
+	var controls []oscalTypes.AssessedControlsSelectControlById
+	for _, controlId := range controlIds {
+		controls = append(controls, oscalTypes.AssessedControlsSelectControlById{ControlId: controlId})
+	}
+	return oscalTypes.Activity{
+		Title: ruleId,
+		RelatedControls: &oscalTypes.ReviewedControls{
+			ControlSelections: []oscalTypes.AssessedControls{
+				{IncludeControls: &controls},
+			},
+		},
+	}
+}
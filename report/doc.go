@@ -0,0 +1,10 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+/*
+Package report defines logic for rendering human-readable compliance reports from OSCAL Assessment Plans and
+Assessment Results.
+*/
+package report
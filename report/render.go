@@ -0,0 +1,95 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package report
+
+import (
+	_ "embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	"text/template"
+)
+
+var (
+	//go:embed templates/report.md.tmpl
+	defaultMarkdownTemplate string
+	//go:embed templates/report.html.tmpl
+	defaultHTMLTemplate string
+)
+
+type renderOpts struct {
+	template string
+}
+
+// RenderOption defines an option to tune the behavior of the
+// RenderMarkdown and RenderHTML functions.
+type RenderOption func(opts *renderOpts)
+
+// WithTemplate is a RenderOption that replaces the default template. The template
+// is executed with a Report and can use the `join`, `text`, `cell`, and `link` functions.
+func WithTemplate(text string) RenderOption {
+	return func(opts *renderOpts) {
+		opts.template = text
+	}
+}
+
+// funcs are the functions available in report templates.
+var funcs = map[string]any{
+	"join": strings.Join,
+	"text": markdownText,
+	"cell": markdownText,
+	"link": markdownLink,
+}
+
+// RenderMarkdown writes the Report as Markdown.
+func RenderMarkdown(w io.Writer, report Report, opts ...RenderOption) error {
+	options := renderOpts{template: defaultMarkdownTemplate}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	tmpl, err := template.New("report").Funcs(funcs).Parse(options.template)
+	if err != nil {
+		return fmt.Errorf("failed to parse markdown template: %w", err)
+	}
+	return tmpl.Execute(w, report)
+}
+
+// RenderHTML writes the Report as a standalone HTML document. The template is
+// executed with contextual escaping.
+func RenderHTML(w io.Writer, report Report, opts ...RenderOption) error {
+	options := renderOpts{template: defaultHTMLTemplate}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	tmpl, err := htmltemplate.New("report").Funcs(funcs).Parse(options.template)
+	if err != nil {
+		return fmt.Errorf("failed to parse html template: %w", err)
+	}
+	return tmpl.Execute(w, report)
+}
+
+// markdownText returns a value that is safe to use on a single Markdown line, such as
+// a heading or a table cell. Line breaks are collapsed and "|" and "#" are escaped.
+func markdownText(value any) string {
+	text := strings.Join(strings.Fields(fmt.Sprint(value)), " ")
+	return markdownEscaper.Replace(text)
+}
+
+// markdownLink returns a Markdown link to the href with the href as the link text.
+func markdownLink(href string) string {
+	destination := href
+	if strings.ContainsAny(href, " ()<>") {
+		destination = "<" + linkEscaper.Replace(href) + ">"
+	}
+	return fmt.Sprintf("[%s](%s)", linkTextEscaper.Replace(markdownText(href)), destination)
+}
+
+var (
+	markdownEscaper = strings.NewReplacer(`|`, `\|`, `#`, `\#`)
+	linkEscaper     = strings.NewReplacer(`<`, `\<`, `>`, `\>`)
+	linkTextEscaper = strings.NewReplacer(`[`, `\[`, `]`, `\]`)
+)
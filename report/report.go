@@ -0,0 +1,256 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package report
+
+import (
+	"sort"
+	"strings"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/plans"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/posture"
+)
+
+// Report defines the content of a compliance report.
+type Report struct {
+	// Title is the Assessment Results title.
+	Title string
+	// PlanTitle is the Assessment Plan title.
+	PlanTitle string
+	// Controls are the reviewed control ids.
+	Controls []string
+	// Rules are the assessed rules in the order of the Assessment Plan activities.
+	Rules []Rule
+	// Summary is the compliance posture summary.
+	Summary posture.Summary
+}
+
+// Rule defines the outcome of an assessed rule.
+type Rule struct {
+	ID          string
+	Description string
+	Controls    []string
+	Parameters  []Parameter
+	Waived      bool
+	Skipped     bool
+	Checks      []Check
+}
+
+// Parameter defines a parameter value used for a rule in a set of controls.
+type Parameter struct {
+	Name     string
+	Value    string
+	Controls []string
+}
+
+// Check defines the outcome of a check. The Result is empty when the check has no result.
+type Check struct {
+	ID          string
+	Description string
+	Result      extensions.Result
+	Reason      string
+	Evidence    []string
+	Subjects    []Subject
+}
+
+// Subject defines the outcome of a check for an Observation subject.
+type Subject struct {
+	UUID     string
+	Title    string
+	Type     string
+	Result   extensions.Result
+	Reason   string
+	Evidence []string
+}
+
+// NewReport returns a Report for Assessment Results generated from the Assessment Plan.
+//
+// Rules are read from the Assessment Plan activities with the activity steps as checks. Observations are
+// matched to checks by the assessment-rule-id and assessment-check-id properties, with the Observation title
+// used when the check property is not set and the activity with the check as a step used when the rule property
+// is not set. Observations for rules not in the Assessment Plan are reported after
+// the planned rules.
+func NewReport(plan oscalTypes.AssessmentPlan, assessmentResults oscalTypes.AssessmentResults) Report {
+	report := Report{
+		Title:     assessmentResults.Metadata.Title,
+		PlanTitle: plan.Metadata.Title,
+		Summary:   posture.Summarize(assessmentResults, posture.WithAssessmentPlan(plan)),
+	}
+
+	controls := set.New[string]()
+	for _, result := range assessmentResults.Results {
+		for _, selection := range result.ReviewedControls.ControlSelections {
+			if selection.IncludeControls == nil {
+				continue
+			}
+			for _, control := range *selection.IncludeControls {
+				if !controls.Has(control.ControlId) {
+					controls.Add(control.ControlId)
+					report.Controls = append(report.Controls, control.ControlId)
+				}
+			}
+		}
+	}
+
+	var activities []oscalTypes.Activity
+	if plan.LocalDefinitions != nil && plan.LocalDefinitions.Activities != nil {
+		activities = *plan.LocalDefinitions.Activities
+	}
+	rulesByCheck := make(map[string]string)
+	for _, activity := range activities {
+		if activity.Steps == nil {
+			continue
+		}
+		for _, step := range *activity.Steps {
+			rulesByCheck[step.Title] = activity.Title
+		}
+	}
+
+	outcomes := newOutcomes(assessmentResults, rulesByCheck)
+	planned := set.New[string]()
+	for _, activity := range activities {
+		planned.Add(activity.Title)
+		report.Rules = append(report.Rules, ruleFromActivity(activity, outcomes))
+	}
+
+	var unplanned []string
+	for ruleId := range outcomes.checkIds {
+		if !planned.Has(ruleId) {
+			unplanned = append(unplanned, ruleId)
+		}
+	}
+	sort.Strings(unplanned)
+	for _, ruleId := range unplanned {
+		rule := Rule{ID: ruleId}
+		for _, checkId := range outcomes.checkIds[ruleId] {
+			rule.Checks = append(rule.Checks, outcomes.check(ruleId, checkId, ""))
+		}
+		report.Rules = append(report.Rules, rule)
+	}
+	return report
+}
+
+// ruleFromActivity returns a Rule for an Assessment Plan activity.
+func ruleFromActivity(activity oscalTypes.Activity, outcomes *outcomes) Rule {
+	rule := Rule{
+		ID:          activity.Title,
+		Description: activity.Description,
+	}
+	if activity.Props != nil {
+		if waived, found := extensions.GetTrestleProp(extensions.WaivedRulesProperty, *activity.Props); found && waived.Value == "true" {
+			rule.Waived = true
+		}
+		if skipped, found := extensions.GetTrestleProp(extensions.SkippedRulesProperty, *activity.Props); found && skipped.Value == "true" {
+			rule.Skipped = true
+		}
+	}
+	for _, group := range plans.ActivityParameters(activity) {
+		for _, param := range group.Parameters {
+			rule.Parameters = append(rule.Parameters, Parameter{Name: param.ID, Value: param.Value, Controls: group.ControlIds})
+		}
+	}
+	if activity.RelatedControls != nil {
+		for _, selection := range activity.RelatedControls.ControlSelections {
+			if selection.IncludeControls == nil {
+				continue
+			}
+			for _, control := range *selection.IncludeControls {
+				rule.Controls = append(rule.Controls, control.ControlId)
+			}
+		}
+	}
+	if activity.Steps != nil {
+		for _, step := range *activity.Steps {
+			rule.Checks = append(rule.Checks, outcomes.check(activity.Title, step.Title, step.Description))
+		}
+	}
+	return rule
+}
+
+// outcomes indexes Observations by rule and check.
+type outcomes struct {
+	checkIds     map[string][]string
+	observations map[string]map[string][]oscalTypes.Observation
+}
+
+// newOutcomes returns outcomes for all Observations in the Assessment Results. Observations without
+// the assessment-rule-id property are mapped to a rule by check.
+func newOutcomes(assessmentResults oscalTypes.AssessmentResults, rulesByCheck map[string]string) *outcomes {
+	o := &outcomes{
+		checkIds:     make(map[string][]string),
+		observations: make(map[string]map[string][]oscalTypes.Observation),
+	}
+	for _, result := range assessmentResults.Results {
+		if result.Observations == nil {
+			continue
+		}
+		for _, observation := range *result.Observations {
+			ruleId, checkId := "", observation.Title
+			if observation.Props != nil {
+				if rule, found := extensions.GetTrestleProp(extensions.AssessmentRuleIdProp, *observation.Props); found {
+					ruleId = rule.Value
+				}
+				if check, found := extensions.GetTrestleProp(extensions.AssessmentCheckIdProp, *observation.Props); found {
+					checkId = check.Value
+				}
+			}
+			if ruleId == "" {
+				ruleId = rulesByCheck[checkId]
+			}
+			byCheck, ok := o.observations[ruleId]
+			if !ok {
+				byCheck = make(map[string][]oscalTypes.Observation)
+				o.observations[ruleId] = byCheck
+			}
+			if _, ok := byCheck[checkId]; !ok {
+				o.checkIds[ruleId] = append(o.checkIds[ruleId], checkId)
+			}
+			byCheck[checkId] = append(byCheck[checkId], observation)
+		}
+	}
+	return o
+}
+
+// check returns the Check with the aggregated outcome of all Observations for the rule and check.
+func (o *outcomes) check(ruleId, checkId, description string) Check {
+	check := Check{ID: checkId, Description: description}
+	var reasons []string
+	var aggregated []extensions.Result
+	for _, observation := range o.observations[ruleId][checkId] {
+		if result, found := results.ObservationResult(observation); found {
+			aggregated = append(aggregated, result)
+		}
+		if reason := results.ObservationReason(observation); reason != "" {
+			reasons = append(reasons, reason)
+		}
+		check.Evidence = append(check.Evidence, results.ObservationEvidence(observation)...)
+		if observation.Subjects == nil {
+			continue
+		}
+		for _, subjectRef := range *observation.Subjects {
+			subject := Subject{
+				UUID:     subjectRef.SubjectUuid,
+				Title:    subjectRef.Title,
+				Type:     subjectRef.Type,
+				Reason:   results.SubjectReason(subjectRef),
+				Evidence: results.SubjectEvidence(subjectRef),
+			}
+			if result, found := results.SubjectResult(subjectRef); found {
+				subject.Result = result
+			}
+			check.Subjects = append(check.Subjects, subject)
+		}
+	}
+	if len(aggregated) > 0 {
+		check.Result = results.AggregateResults(aggregated...)
+	}
+	check.Reason = strings.Join(reasons, "; ")
+	return check
+}
@@ -0,0 +1,236 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package report
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/validation"
+)
+
+func TestNewReport(t *testing.T) {
+	plan, assessmentResults := testAssessment(t)
+	report := NewReport(*plan, *assessmentResults)
+
+	require.Equal(t, "Test Results", report.Title)
+	require.Equal(t, []string{"ex-2", "ex-1"}, report.Controls)
+	require.Len(t, report.Rules, 2)
+
+	rule := report.Rules[0]
+	require.Equal(t, "rule-1", rule.ID)
+	require.Equal(t, "Rule 1 description", rule.Description)
+	require.Equal(t, []string{"ex-2", "ex-1"}, rule.Controls)
+	require.Equal(t, []Parameter{{Name: "param-1", Value: "", Controls: []string{"ex-2", "ex-1"}}}, rule.Parameters)
+	require.Equal(t, []Check{
+		{
+			ID:          "check-1",
+			Description: "Check 1 Description",
+			Result:      extensions.ResultFail,
+			Reason:      "1 of 2 subjects failed",
+			Evidence:    []string{"https://example.com/report"},
+			Subjects: []Subject{
+				{
+					UUID:     "11111111-1111-4111-8111-111111111111",
+					Title:    "Component <1>",
+					Type:     "component",
+					Result:   extensions.ResultFail,
+					Reason:   "missing | label",
+					Evidence: []string{"https://example.com/evidence"},
+				},
+			},
+		},
+	}, rule.Checks)
+	require.Equal(t, 1, report.Summary.Total.Fail)
+}
+
+func TestRender(t *testing.T) {
+	plan, assessmentResults := testAssessment(t)
+	report := NewReport(*plan, *assessmentResults)
+
+	tests := []struct {
+		name         string
+		render       func(*bytes.Buffer, Report, ...RenderOption) error
+		options      []RenderOption
+		wantContains []string
+		wantErr      string
+	}{
+		{
+			name:   "Valid/Markdown",
+			render: func(b *bytes.Buffer, r Report, opts ...RenderOption) error { return RenderMarkdown(b, r, opts...) },
+			wantContains: []string{
+				"# Test Results",
+				"- ex-1",
+				"### rule-1",
+				"| param-1 |  | ex-2, ex-1 |",
+				"| check-1 | fail | 1 of 2 subjects failed | [https://example.com/report](https://example.com/report) |",
+				"| Component <1> | component | fail | missing \\| label | [https://example.com/evidence](https://example.com/evidence) |",
+			},
+		},
+		{
+			name:   "Valid/HTML",
+			render: func(b *bytes.Buffer, r Report, opts ...RenderOption) error { return RenderHTML(b, r, opts...) },
+			wantContains: []string{
+				"<h1>Test Results</h1>",
+				"<li>ex-1</li>",
+				`<h4>check-1: <span class="fail">fail</span></h4>`,
+				"<td>Component &lt;1&gt;</td>",
+				`<a href="https://example.com/evidence">`,
+			},
+		},
+		{
+			name:         "Valid/WithTemplate",
+			render:       func(b *bytes.Buffer, r Report, opts ...RenderOption) error { return RenderMarkdown(b, r, opts...) },
+			options:      []RenderOption{WithTemplate(`{{ range .Rules }}{{ .ID }};{{ end }}`)},
+			wantContains: []string{"rule-1;rule-2;"},
+		},
+		{
+			name:    "Invalid/Template",
+			render:  func(b *bytes.Buffer, r Report, opts ...RenderOption) error { return RenderHTML(b, r, opts...) },
+			options: []RenderOption{WithTemplate(`{{ .Rules`)},
+			wantErr: "failed to parse html template",
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := c.render(&buf, report, c.options...)
+			if c.wantErr != "" {
+				require.ErrorContains(t, err, c.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			for _, want := range c.wantContains {
+				require.Contains(t, buf.String(), want)
+			}
+		})
+	}
+}
+
+func TestNewReport_ControlParameters(t *testing.T) {
+	parameter := func(value string) []oscalTypes.Property {
+		return []oscalTypes.Property{
+			{Name: "param-1", Value: value, Ns: extensions.TrestleNameSpace, Class: extensions.TestParameterClass},
+		}
+	}
+	selection := func(props []oscalTypes.Property, controlIds ...string) oscalTypes.AssessedControls {
+		var controls []oscalTypes.AssessedControlsSelectControlById
+		for _, controlId := range controlIds {
+			controls = append(controls, oscalTypes.AssessedControlsSelectControlById{ControlId: controlId})
+		}
+		return oscalTypes.AssessedControls{IncludeControls: &controls, Props: &props}
+	}
+	activityProps := parameter("value-1")
+	plan := oscalTypes.AssessmentPlan{
+		LocalDefinitions: &oscalTypes.LocalDefinitions{
+			Activities: &[]oscalTypes.Activity{
+				{
+					Title: "rule-1",
+					Props: &activityProps,
+					RelatedControls: &oscalTypes.ReviewedControls{
+						ControlSelections: []oscalTypes.AssessedControls{
+							selection(parameter("value-1"), "ex-1", "ex-3"),
+							selection(parameter("value-2"), "ex-2"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	report := NewReport(plan, oscalTypes.AssessmentResults{})
+	require.Len(t, report.Rules, 1)
+	require.Equal(t, []string{"ex-1", "ex-3", "ex-2"}, report.Rules[0].Controls)
+	require.Equal(t, []Parameter{
+		{Name: "param-1", Value: "value-1", Controls: []string{"ex-1", "ex-3"}},
+		{Name: "param-1", Value: "value-2", Controls: []string{"ex-2"}},
+	}, report.Rules[0].Parameters)
+
+	var buf bytes.Buffer
+	require.NoError(t, RenderMarkdown(&buf, report))
+	require.Contains(t, buf.String(), "| param-1 | value-1 | ex-1, ex-3 |")
+	require.Contains(t, buf.String(), "| param-1 | value-2 | ex-2 |")
+
+	buf.Reset()
+	require.NoError(t, RenderHTML(&buf, report))
+	require.Contains(t, buf.String(), "<tr><td>param-1</td><td>value-2</td><td>ex-2</td></tr>")
+}
+
+func testAssessment(t *testing.T) (*oscalTypes.AssessmentPlan, *oscalTypes.AssessmentResults) {
+	file, err := os.Open("../testdata/test-ap.json")
+	require.NoError(t, err)
+	defer file.Close()
+	plan, err := models.NewAssessmentPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	subject := oscalTypes.SubjectReference{
+		SubjectUuid: "11111111-1111-4111-8111-111111111111",
+		Title:       "Component <1>",
+		Type:        "component",
+	}
+	results.SetSubjectResult(&subject, extensions.ResultFail, "missing | label")
+	results.AddSubjectEvidence(&subject, "https://example.com/evidence")
+	observation := oscalTypes.Observation{
+		UUID:  "22222222-2222-4222-8222-222222222222",
+		Title: "check-1",
+		Props: &[]oscalTypes.Property{
+			{Name: extensions.AssessmentCheckIdProp, Ns: extensions.TrestleNameSpace, Value: "check-1"},
+			extensions.NewReasonProp("1 of 2 subjects failed"),
+		},
+		Subjects: &[]oscalTypes.SubjectReference{subject},
+	}
+	results.AddObservationEvidence(&observation, "https://example.com/report", "Test report")
+
+	assessmentResults, err := results.GenerateAssessmentResults(*plan,
+		results.WithTitle("Test Results"),
+		results.WithObservations([]oscalTypes.Observation{observation}),
+	)
+	require.NoError(t, err)
+	return plan, assessmentResults
+}
+
+func TestMarkdownEscaping(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{
+			name: "Valid/TextNewlines",
+			got:  markdownText("line one\nline two"),
+			want: "line one line two",
+		},
+		{
+			name: "Valid/TextPipeAndHash",
+			got:  markdownText("#1 | fixed"),
+			want: `\#1 \| fixed`,
+		},
+		{
+			name: "Valid/Link",
+			got:  markdownLink("https://example.com/report"),
+			want: "[https://example.com/report](https://example.com/report)",
+		},
+		{
+			name: "Valid/LinkWithSpaces",
+			got:  markdownLink("evidence/my report (1).txt"),
+			want: "[evidence/my report (1).txt](<evidence/my report (1).txt>)",
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.want, c.got)
+		})
+	}
+}
@@ -25,10 +25,12 @@ func NewSettings(rules map[string]struct{}, parameters map[string]string) Settin
 // Set and the nested Implemented Requirements.
 func NewImplementationSettings(controlImplementation components.Implementation) *ImplementationSettings {
 	implementation := &ImplementationSettings{
-		implementedReqSettings: make(map[string]Settings),
-		settings:               NewSettings(set.New[string](), make(map[string]string)),
-		controlsByRules:        make(map[string]set.Set[string]),
-		controlsById:           make(map[string]oscalTypes.AssessedControlsSelectControlById),
+		implementedReqSettings:  make(map[string]Settings),
+		implementedStmSettings:  make(map[string]map[string]Settings),
+		implementedCompSettings: make(map[string]map[string]Settings),
+		settings:                NewSettings(set.New[string](), make(map[string]string)),
+		controlsByRules:         make(map[string]set.Set[string]),
+		controlsById:            make(map[string]oscalTypes.AssessedControlsSelectControlById),
 	}
 	setParameters(controlImplementation.SetParameters(), implementation.settings.selectedParameters)
 
@@ -97,6 +99,70 @@ func newRequirementForImplementation(implementedReq components.Requirement, impl
 		}
 
 		implementation.implementedReqSettings[implementedReq.ControlID()] = requirement
+		byComponentsForImplementation(implementedReq, implementation)
+		statementsForImplementation(implementedReq, implementation)
+	}
+}
+
+// byComponentsForImplementation adds by-component level Settings for an implemented requirement
+// to an existing ImplementationSettings. Only components with mapped rules and parameters are stored because
+// by-component level parameters only apply to the rules mapped by the component.
+func byComponentsForImplementation(implementedReq components.Requirement, implementation *ImplementationSettings) {
+	componentReq, ok := implementedReq.(components.ComponentRequirement)
+	if !ok {
+		return
+	}
+	for _, byComp := range componentReq.ByComponents() {
+		component := NewSettings(set.New[string](), make(map[string]string))
+		for _, mappedRule := range extensions.FindAllProps(byComp.Props(), extensions.WithName(extensions.RuleIdProp)) {
+			component.mappedRules.Add(mappedRule.Value)
+		}
+		setParameters(byComp.SetParameters(), component.selectedParameters)
+		if len(component.mappedRules) == 0 || len(component.selectedParameters) == 0 {
+			continue
+		}
+		byComponents, ok := implementation.implementedCompSettings[implementedReq.ControlID()]
+		if !ok {
+			byComponents = make(map[string]Settings)
+		}
+		if existing, ok := byComponents[byComp.ComponentUUID()]; ok {
+			for mappedRule := range component.mappedRules {
+				existing.mappedRules.Add(mappedRule)
+			}
+			for name, value := range component.selectedParameters {
+				existing.selectedParameters[name] = value
+			}
+			component = existing
+		}
+		byComponents[byComp.ComponentUUID()] = component
+		implementation.implementedCompSettings[implementedReq.ControlID()] = byComponents
+	}
+}
+
+// statementsForImplementation adds statement level Settings for an implemented requirement
+// to an existing ImplementationSettings. Only statements with mapped rules and parameters are stored because
+// statement level parameters only apply to the rules mapped to the statement.
+func statementsForImplementation(implementedReq components.Requirement, implementation *ImplementationSettings) {
+	for _, stm := range implementedReq.Statements() {
+		statement := settingsFromStatement(stm)
+		if len(statement.mappedRules) == 0 || len(statement.selectedParameters) == 0 {
+			continue
+		}
+		statements, ok := implementation.implementedStmSettings[implementedReq.ControlID()]
+		if !ok {
+			statements = make(map[string]Settings)
+		}
+		if existing, ok := statements[stm.StatementID()]; ok {
+			for mappedRule := range statement.mappedRules {
+				existing.mappedRules.Add(mappedRule)
+			}
+			for name, value := range statement.selectedParameters {
+				existing.selectedParameters[name] = value
+			}
+			statement = existing
+		}
+		statements[stm.StatementID()] = statement
+		implementation.implementedStmSettings[implementedReq.ControlID()] = statements
 	}
 }
 
@@ -125,6 +191,22 @@ func settingsFromImplementedRequirement(implementedReq components.Requirement) S
 	return requirement
 }
 
+// settingsFromStatement returns Settings populated with data from an
+// implemented statement.
+func settingsFromStatement(stm components.Statement) Settings {
+	statement := NewSettings(set.New[string](), make(map[string]string))
+
+	mappedRulesProps := extensions.FindAllProps(stm.Props(), extensions.WithName(extensions.RuleIdProp))
+	for _, mappedRule := range mappedRulesProps {
+		statement.mappedRules.Add(mappedRule.Value)
+	}
+
+	if parameterized, ok := stm.(components.ParameterizedStatement); ok {
+		setParameters(parameterized.SetParameters(), statement.selectedParameters)
+	}
+	return statement
+}
+
 // setParameters updates the paramMap with the input list of SetParameters.
 func setParameters(parameters []oscalTypes.SetParameter, paramMap map[string]string) {
 	for _, prm := range parameters {
@@ -8,11 +8,13 @@ package settings
 import (
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 
 	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
 	"github.com/oscal-compass/oscal-sdk-go/models/components"
 )
 
@@ -79,3 +81,159 @@ func ByFramework(framework string, controlImplementations []oscalTypes.ControlIm
 	}
 	return implementationSettings, frameworkSource, nil
 }
+
+// Framework defines summary information for a framework found
+// in one or more control implementations.
+type Framework struct {
+	// ShortName is the human-readable short name for the framework.
+	ShortName string
+	// Sources contains the unique control sources associated with the framework.
+	Sources []FrameworkSource
+	// ComponentCount is the number of components that implement the framework.
+	ComponentCount int
+	// RuleCount is the number of unique rules mapped to the framework.
+	RuleCount int
+}
+
+// frameworkIndex collects information about a single framework
+// during discovery.
+type frameworkIndex struct {
+	sources    []FrameworkSource
+	hrefs      set.Set[string]
+	components set.Set[string]
+	settings   *ImplementationSettings
+}
+
+func newFrameworkIndex() *frameworkIndex {
+	return &frameworkIndex{
+		hrefs:      set.New[string](),
+		components: set.New[string](),
+	}
+}
+
+// add indexes a control implementation for a given framework and component.
+func (f *frameworkIndex) add(shortName, componentID, description, href string, implementation components.Implementation) {
+	f.components.Add(componentID)
+	if !f.hrefs.Has(href) {
+		f.hrefs.Add(href)
+		f.sources = append(f.sources, FrameworkSource{
+			Title:       shortName,
+			Description: description,
+			Href:        href,
+		})
+	}
+	if f.settings == nil {
+		f.settings = NewImplementationSettings(implementation)
+	} else {
+		f.settings.merge(implementation)
+	}
+}
+
+// Frameworks returns summary information for all frameworks found in the control implementations of the
+// given OSCAL Component Definitions sorted by short name.
+//
+// Components are identified by title, which is consistent with how components are identified in a rules.Store.
+func Frameworks(definitions []oscalTypes.ComponentDefinition) []Framework {
+	indexByName := make(map[string]*frameworkIndex)
+	for _, compDef := range definitions {
+		if compDef.Components == nil {
+			continue
+		}
+		for _, comp := range *compDef.Components {
+			if comp.ControlImplementations == nil {
+				continue
+			}
+			for _, controlImplementation := range *comp.ControlImplementations {
+				frameworkShortName, found := GetFrameworkShortName(controlImplementation)
+				if !found {
+					continue
+				}
+				index, ok := indexByName[frameworkShortName]
+				if !ok {
+					index = newFrameworkIndex()
+					indexByName[frameworkShortName] = index
+				}
+				implementationAdapter := components.NewControlImplementationSetAdapter(controlImplementation)
+				index.add(frameworkShortName, comp.Title, controlImplementation.Description, controlImplementation.Source, implementationAdapter)
+			}
+		}
+	}
+
+	frameworks := make([]Framework, 0, len(indexByName))
+	for shortName, index := range indexByName {
+		frameworks = append(frameworks, Framework{
+			ShortName:      shortName,
+			Sources:        index.sources,
+			ComponentCount: len(index.components),
+			RuleCount:      len(index.settings.settings.mappedRules),
+		})
+	}
+	sort.Slice(frameworks, func(i, j int) bool {
+		return frameworks[i].ShortName < frameworks[j].ShortName
+	})
+	return frameworks
+}
+
+// FrameworkFromSSP returns summary information for the framework implemented in an OSCAL System Security Plan.
+//
+// The framework short name is determined from the imported profile href. Only system components with mapped
+// rules in the control implementation are counted.
+func FrameworkFromSSP(ssp oscalTypes.SystemSecurityPlan) (Framework, error) {
+	frameworkShortName, found := GetFrameworkShortName(oscalTypes.ControlImplementationSet{Source: ssp.ImportProfile.Href})
+	if !found {
+		return Framework{}, fmt.Errorf("framework not found for imported profile %q", ssp.ImportProfile.Href)
+	}
+
+	systemComponents := make(map[string]string)
+	for _, comp := range ssp.SystemImplementation.Components {
+		systemComponents[comp.UUID] = comp.Title
+	}
+
+	componentTitles := set.New[string]()
+	for _, requirement := range ssp.ControlImplementation.ImplementedRequirements {
+		byComponents := byComponentsForRequirement(requirement)
+		for _, byComp := range byComponents {
+			title, ok := systemComponents[byComp.ComponentUuid]
+			if !ok || byComp.Props == nil {
+				continue
+			}
+			ruleProps := extensions.FindAllProps(*byComp.Props, extensions.WithName(extensions.RuleIdProp))
+			if len(ruleProps) > 0 {
+				componentTitles.Add(title)
+			}
+		}
+	}
+
+	implementationAdapter := components.NewControlImplementationAdapter(ssp.ControlImplementation)
+	implementationSettings := NewImplementationSettings(implementationAdapter)
+	return Framework{
+		ShortName: frameworkShortName,
+		Sources: []FrameworkSource{
+			{
+				Title:       frameworkShortName,
+				Description: ssp.ControlImplementation.Description,
+				Href:        ssp.ImportProfile.Href,
+			},
+		},
+		ComponentCount: len(componentTitles),
+		RuleCount:      len(implementationSettings.settings.mappedRules),
+	}, nil
+}
+
+// byComponentsForRequirement returns all by-component entries for an implemented requirement,
+// including entries in the requirement statements.
+func byComponentsForRequirement(requirement oscalTypes.ImplementedRequirement) []oscalTypes.ByComponent {
+	var byComponents []oscalTypes.ByComponent
+	if requirement.ByComponents != nil {
+		byComponents = append(byComponents, *requirement.ByComponents...)
+	}
+	if requirement.Statements == nil {
+		return byComponents
+	}
+	for _, stm := range *requirement.Statements {
+		if stm.ByComponents != nil {
+			byComponents = append(byComponents, *stm.ByComponents...)
+		}
+	}
+	return byComponents
+}
@@ -86,6 +86,8 @@ func TestByFramework(t *testing.T) {
 	implementationsMap, framework, err := ByFramework("cis", allImplementations)
 	require.NoError(t, err)
 	expectedSettings := &ImplementationSettings{
+		implementedStmSettings:  map[string]map[string]Settings{},
+		implementedCompSettings: map[string]map[string]Settings{},
 		settings: Settings{
 			mappedRules: set.Set[string]{
 				"etcd_cert_file": struct{}{},
@@ -129,3 +131,72 @@ func TestByFramework(t *testing.T) {
 	_, _, err = ByFramework("doesnotexist", allImplementations)
 	require.EqualError(t, err, "framework doesnotexist is not in control implementations")
 }
+
+func TestFrameworks(t *testing.T) {
+	var definitions []oscalTypes.ComponentDefinition
+	for _, testFile := range []string{"component-definition-test-reqs.json", "component-definition-test2.json"} {
+		file, err := os.Open(filepath.Join("../testdata", testFile))
+		require.NoError(t, err)
+		definition, err := models.NewComponentDefinition(file, validation.NoopValidator{})
+		require.NoError(t, err)
+		require.NotNil(t, definition)
+		definitions = append(definitions, *definition)
+	}
+
+	expectedFrameworks := []Framework{
+		{
+			ShortName: "cis",
+			Sources: []FrameworkSource{
+				{
+					Title:       "cis",
+					Description: "CIS Profile",
+					Href:        "profiles/cis/profile.json",
+				},
+			},
+			ComponentCount: 2,
+			RuleCount:      3,
+		},
+		{
+			ShortName: "example",
+			Sources: []FrameworkSource{
+				{
+					Title:       "example",
+					Description: "Example profiles",
+					Href:        "profiles/example/profile.json",
+				},
+			},
+			ComponentCount: 1,
+			RuleCount:      1,
+		},
+	}
+	require.Equal(t, expectedFrameworks, Frameworks(definitions))
+	require.Empty(t, Frameworks(nil))
+}
+
+func TestFrameworkFromSSP(t *testing.T) {
+	file, err := os.Open(filepath.Join("../testdata", "test-ssp.json"))
+	require.NoError(t, err)
+	ssp, err := models.NewSystemSecurityPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+	require.NotNil(t, ssp)
+
+	framework, err := FrameworkFromSSP(*ssp)
+	require.NoError(t, err)
+	expectedFramework := Framework{
+		ShortName: "example",
+		Sources: []FrameworkSource{
+			{
+				Title:       "example",
+				Description: "This is an example control implementation for the system.",
+				Href:        "profiles/example/profile.json",
+			},
+		},
+		ComponentCount: 1,
+		RuleCount:      2,
+	}
+	require.Equal(t, expectedFramework, framework)
+
+	ssp.ImportProfile.Href = "profile.json"
+	_, err = FrameworkFromSSP(*ssp)
+	require.EqualError(t, err, "framework not found for imported profile \"profile.json\"")
+}
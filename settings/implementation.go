@@ -7,6 +7,7 @@ package settings
 
 import (
 	"fmt"
+	"sort"
 
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 
@@ -20,6 +21,12 @@ type ImplementationSettings struct {
 	// implementedReqSettings defines settings for RuleSets at the
 	// implemented requirement/statement level.
 	implementedReqSettings map[string]Settings
+	// implementedStmSettings defines settings for RuleSets at the
+	// statement level indexed by control ID and statement ID.
+	implementedStmSettings map[string]map[string]Settings
+	// implementedCompSettings defines settings for RuleSets at the
+	// by-component level indexed by control ID and component UUID.
+	implementedCompSettings map[string]map[string]Settings
 	// settings defines the settings for the
 	// overall implementation of the requirements.
 	settings Settings
@@ -41,12 +48,13 @@ func (i *ImplementationSettings) AllSettings() Settings {
 }
 
 // AllControls returns AssessedControlsSelectControlByID with all controls and associated statements that are applicable to
-// the control implementation.
+// the control implementation, sorted by control id.
 func (i *ImplementationSettings) AllControls() []oscalTypes.AssessedControlsSelectControlById {
 	var allControls []oscalTypes.AssessedControlsSelectControlById
 	for _, assessedControls := range i.controlsById {
 		allControls = append(allControls, assessedControls)
 	}
+	sortControls(allControls)
 	return allControls
 }
 
@@ -60,8 +68,55 @@ func (i *ImplementationSettings) ByControlID(controlId string) (Settings, error)
 	return requirement, nil
 }
 
+// ApplicableSettings returns the Settings for a given rule in the context of a single control.
+//
+// Parameter values are resolved in order of precedence with the control implementation having the
+// lowest precedence, followed by the implemented requirement, then any components implementing the requirement
+// that map the rule, then any statements in the requirement that map the rule.
+func (i *ImplementationSettings) ApplicableSettings(ruleId, controlId string) (Settings, error) {
+	requirement, err := i.ByControlID(controlId)
+	if err != nil {
+		return Settings{}, err
+	}
+	if !requirement.ContainsRule(ruleId) {
+		return Settings{}, fmt.Errorf("rule id %s not found in settings for control %s", ruleId, controlId)
+	}
+
+	resolved := NewSettings(set.New[string](), make(map[string]string))
+	resolved.mappedRules.Add(ruleId)
+	for name, value := range i.settings.selectedParameters {
+		resolved.selectedParameters[name] = value
+	}
+	for name, value := range requirement.selectedParameters {
+		resolved.selectedParameters[name] = value
+	}
+	applyRuleSettings(resolved, i.implementedCompSettings[controlId], ruleId)
+	applyRuleSettings(resolved, i.implementedStmSettings[controlId], ruleId)
+	return resolved, nil
+}
+
+// applyRuleSettings updates the resolved Settings with the parameter values from the scoped Settings
+// that map the rule. The scoped Settings are applied in order of their keys to ensure a stable result
+// when more than one sets the same parameter for a rule.
+func applyRuleSettings(resolved Settings, scoped map[string]Settings, ruleId string) {
+	keys := make([]string, 0, len(scoped))
+	for key := range scoped {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		settings := scoped[key]
+		if !settings.ContainsRule(ruleId) {
+			continue
+		}
+		for name, value := range settings.selectedParameters {
+			resolved.selectedParameters[name] = value
+		}
+	}
+}
+
 // ApplicableControls finds controls and corresponding statements that are applicable to a given rule based in the control
-// implementation.
+// implementation, sorted by control id.
 func (i *ImplementationSettings) ApplicableControls(ruleId string) ([]oscalTypes.AssessedControlsSelectControlById, error) {
 	controls, ok := i.controlsByRules[ruleId]
 	if !ok {
@@ -75,9 +130,18 @@ func (i *ImplementationSettings) ApplicableControls(ruleId string) ([]oscalTypes
 		}
 		assessedControls = append(assessedControls, assessedControl)
 	}
+	sortControls(assessedControls)
 	return assessedControls, nil
 }
 
+// sortControls sorts assessed controls by control id, so generated models do not
+// depend on map iteration order.
+func sortControls(controls []oscalTypes.AssessedControlsSelectControlById) {
+	sort.Slice(controls, func(i, j int) bool {
+		return controls[i].ControlId < controls[j].ControlId
+	})
+}
+
 // merge another ImplementationSettings into the ImplementationSettings. Existing settings at the
 // requirements level are also merged.
 func (i *ImplementationSettings) merge(inputImplementation components.Implementation) {
@@ -108,6 +172,8 @@ func (i *ImplementationSettings) merge(inputImplementation components.Implementa
 				reqSettings.selectedParameters[name] = value
 			}
 			i.implementedReqSettings[requirement.ControlID()] = reqSettings
+			byComponentsForImplementation(requirement, i)
+			statementsForImplementation(requirement, i)
 		}
 	}
 }
@@ -60,6 +60,8 @@ func TestMerge(t *testing.T) {
 				},
 			},
 			wantSettings: ImplementationSettings{
+				implementedStmSettings:  map[string]map[string]Settings{},
+				implementedCompSettings: map[string]map[string]Settings{},
 				settings: Settings{
 					mappedRules: set.Set[string]{
 						"etcd_cert_file": struct{}{},
@@ -134,6 +136,8 @@ func TestMerge(t *testing.T) {
 				},
 			},
 			wantSettings: ImplementationSettings{
+				implementedStmSettings:  map[string]map[string]Settings{},
+				implementedCompSettings: map[string]map[string]Settings{},
 				settings: Settings{
 					mappedRules: set.Set[string]{
 						"etcd_cert_file": struct{}{},
@@ -189,6 +193,8 @@ func TestMerge(t *testing.T) {
 				},
 			},
 			wantSettings: ImplementationSettings{
+				implementedStmSettings:  map[string]map[string]Settings{},
+				implementedCompSettings: map[string]map[string]Settings{},
 				settings: Settings{
 					mappedRules: set.Set[string]{
 						"etcd_cert_file": struct{}{},
@@ -257,6 +263,184 @@ func TestImplementationSettings_Controls(t *testing.T) {
 	require.Equal(t, expectedControlIds, gotControlIds)
 }
 
+func TestImplementationSettings_ApplicableSettings(t *testing.T) {
+	testImplementation := oscalTypes.ControlImplementation{
+		SetParameters: &[]oscalTypes.SetParameter{
+			{
+				ParamId: "my-test-param",
+				Values:  []string{"implementation-value"},
+			},
+			{
+				ParamId: "my-other-param",
+				Values:  []string{"implementation-value"},
+			},
+		},
+		ImplementedRequirements: []oscalTypes.ImplementedRequirement{
+			{
+				ControlId: "ex-1",
+				Props: &[]oscalTypes.Property{
+					{
+						Name:  extensions.RuleIdProp,
+						Value: "my-test-rule",
+						Ns:    extensions.TrestleNameSpace,
+					},
+				},
+				SetParameters: &[]oscalTypes.SetParameter{
+					{
+						ParamId: "my-test-param",
+						Values:  []string{"requirement-value"},
+					},
+				},
+				Statements: &[]oscalTypes.Statement{
+					{
+						StatementId: "ex-1_smt.a",
+						ByComponents: &[]oscalTypes.ByComponent{
+							{
+								Props: &[]oscalTypes.Property{
+									{
+										Name:  extensions.RuleIdProp,
+										Value: "my-test-rule-2",
+										Ns:    extensions.TrestleNameSpace,
+									},
+								},
+								SetParameters: &[]oscalTypes.SetParameter{
+									{
+										ParamId: "my-test-param",
+										Values:  []string{"statement-value"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				ControlId: "ex-2",
+				Props: &[]oscalTypes.Property{
+					{
+						Name:  extensions.RuleIdProp,
+						Value: "my-test-rule",
+						Ns:    extensions.TrestleNameSpace,
+					},
+				},
+			},
+		},
+	}
+	adapter := components.NewControlImplementationAdapter(testImplementation)
+	testSettings := NewImplementationSettings(adapter)
+
+	tests := []struct {
+		name           string
+		ruleId         string
+		controlId      string
+		wantParameters map[string]string
+		expError       string
+	}{
+		{
+			name:      "Valid/ImplementationPrecedence",
+			ruleId:    "my-test-rule",
+			controlId: "ex-2",
+			wantParameters: map[string]string{
+				"my-test-param":  "implementation-value",
+				"my-other-param": "implementation-value",
+			},
+		},
+		{
+			name:      "Valid/RequirementPrecedence",
+			ruleId:    "my-test-rule",
+			controlId: "ex-1",
+			wantParameters: map[string]string{
+				"my-test-param":  "requirement-value",
+				"my-other-param": "implementation-value",
+			},
+		},
+		{
+			name:      "Valid/StatementPrecedence",
+			ruleId:    "my-test-rule-2",
+			controlId: "ex-1",
+			wantParameters: map[string]string{
+				"my-test-param":  "statement-value",
+				"my-other-param": "implementation-value",
+			},
+		},
+		{
+			name:      "Invalid/ControlNotFound",
+			ruleId:    "my-test-rule",
+			controlId: "ex-3",
+			expError:  "control ex-3 not found in settings",
+		},
+		{
+			name:      "Invalid/RuleNotInControl",
+			ruleId:    "my-test-rule-2",
+			controlId: "ex-2",
+			expError:  "rule id my-test-rule-2 not found in settings for control ex-2",
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			gotSettings, err := testSettings.ApplicableSettings(c.ruleId, c.controlId)
+			if c.expError != "" {
+				require.EqualError(t, err, c.expError)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, gotSettings.ContainsRule(c.ruleId))
+			require.Equal(t, c.wantParameters, gotSettings.selectedParameters)
+		})
+	}
+}
+
+func TestImplementationSettings_ApplicableSettingsMerged(t *testing.T) {
+	ruleProps := func(ruleId string) *[]oscalTypes.Property {
+		return &[]oscalTypes.Property{
+			{
+				Name:  extensions.RuleIdProp,
+				Value: ruleId,
+				Ns:    extensions.TrestleNameSpace,
+			},
+		}
+	}
+	first := oscalTypes.ControlImplementation{
+		ImplementedRequirements: []oscalTypes.ImplementedRequirement{
+			{
+				ControlId: "ex-1",
+				Props:     ruleProps("my-test-rule"),
+			},
+		},
+	}
+	second := oscalTypes.ControlImplementation{
+		ImplementedRequirements: []oscalTypes.ImplementedRequirement{
+			{
+				ControlId: "ex-1",
+				Statements: &[]oscalTypes.Statement{
+					{
+						StatementId: "ex-1_smt.a",
+						ByComponents: &[]oscalTypes.ByComponent{
+							{
+								Props: ruleProps("my-test-rule-2"),
+								SetParameters: &[]oscalTypes.SetParameter{
+									{
+										ParamId: "my-test-param",
+										Values:  []string{"statement-value"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testSettings := NewImplementationSettings(components.NewControlImplementationAdapter(first))
+	testSettings.merge(components.NewControlImplementationAdapter(second))
+
+	gotSettings, err := testSettings.ApplicableSettings("my-test-rule-2", "ex-1")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"my-test-param": "statement-value"}, gotSettings.selectedParameters)
+}
+
 func prepSettings(t *testing.T) *ImplementationSettings {
 	testDataPath := filepath.Join("../testdata", "component-definition-test-reqs.json")
 
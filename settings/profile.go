@@ -0,0 +1,119 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package settings
+
+import (
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+)
+
+// NewProfileSettings returns Settings with parameter values tailored by an OSCAL Profile.
+//
+// Parameter values are collected from the resolved profile catalog, if provided, and then
+// overridden by the profile `modify.set-parameters`. The returned Settings do not have any
+// mapped rules because rules are selected by control implementations. Because tailored values take
+// precedence over control implementation values, only pass a resolved catalog when the catalog values
+// should also override them.
+func NewProfileSettings(profile oscalTypes.Profile, resolvedCatalog *oscalTypes.Catalog) Settings {
+	parameters := make(map[string]string)
+	if resolvedCatalog != nil {
+		catalogParameters(*resolvedCatalog, parameters)
+	}
+
+	if profile.Modify != nil && profile.Modify.SetParameters != nil {
+		for _, prm := range *profile.Modify.SetParameters {
+			// Parameter values set for trestle Rule selection
+			// should only map to a single value.
+			if prm.Values == nil || len(*prm.Values) != 1 {
+				continue
+			}
+			parameters[prm.ParamId] = (*prm.Values)[0]
+		}
+	}
+	return NewSettings(set.New[string](), parameters)
+}
+
+// NewImplementationSettingsFromProfile returns ImplementationSettings populated with data from an OSCAL Control
+// Implementation and tailored with parameter values from an OSCAL Profile.
+//
+// Parameter values from the resolved profile catalog, if provided, are only used when a parameter is not set by the
+// control implementation. The profile `modify.set-parameters` are applied with `Tailor`.
+func NewImplementationSettingsFromProfile(controlImplementation components.Implementation, profile oscalTypes.Profile, resolvedCatalog *oscalTypes.Catalog) *ImplementationSettings {
+	implementation := NewImplementationSettings(controlImplementation)
+	if resolvedCatalog != nil {
+		catalogDefaults := make(map[string]string)
+		catalogParameters(*resolvedCatalog, catalogDefaults)
+		for name, value := range catalogDefaults {
+			if _, ok := implementation.settings.selectedParameters[name]; !ok {
+				implementation.settings.selectedParameters[name] = value
+			}
+		}
+	}
+	implementation.Tailor(NewProfileSettings(profile, nil))
+	return implementation
+}
+
+// Tailor updates the ImplementationSettings with parameter values from the given Settings, such as the Settings
+// from NewProfileSettings.
+//
+// The tailored values take precedence over the values set by the control implementation, but the values set by
+// an implemented requirement, a component implementing the requirement, or a statement still take precedence over
+// the tailored values for the rules in that control.
+func (i *ImplementationSettings) Tailor(baseline Settings) {
+	for name, value := range baseline.selectedParameters {
+		i.settings.selectedParameters[name] = value
+	}
+}
+
+// catalogParameters updates the paramMap with all single value parameters defined at any level
+// in a catalog.
+func catalogParameters(catalog oscalTypes.Catalog, paramMap map[string]string) {
+	if catalog.Params != nil {
+		parameterValues(*catalog.Params, paramMap)
+	}
+	if catalog.Groups != nil {
+		groupParameters(*catalog.Groups, paramMap)
+	}
+	if catalog.Controls != nil {
+		controlParameters(*catalog.Controls, paramMap)
+	}
+}
+
+func groupParameters(groups []oscalTypes.Group, paramMap map[string]string) {
+	for _, group := range groups {
+		if group.Params != nil {
+			parameterValues(*group.Params, paramMap)
+		}
+		if group.Groups != nil {
+			groupParameters(*group.Groups, paramMap)
+		}
+		if group.Controls != nil {
+			controlParameters(*group.Controls, paramMap)
+		}
+	}
+}
+
+func controlParameters(controls []oscalTypes.Control, paramMap map[string]string) {
+	for _, control := range controls {
+		if control.Params != nil {
+			parameterValues(*control.Params, paramMap)
+		}
+		if control.Controls != nil {
+			controlParameters(*control.Controls, paramMap)
+		}
+	}
+}
+
+func parameterValues(parameters []oscalTypes.Parameter, paramMap map[string]string) {
+	for _, prm := range parameters {
+		if prm.Values == nil || len(*prm.Values) != 1 {
+			continue
+		}
+		paramMap[prm.ID] = (*prm.Values)[0]
+	}
+}
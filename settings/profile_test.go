@@ -0,0 +1,199 @@
+/*
+ Copyright 2025 The OSCAL Compass Authors
+ SPDX-License-Identifier: Apache-2.0
+*/
+
+package settings
+
+import (
+	"testing"
+
+	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
+	"github.com/oscal-compass/oscal-sdk-go/internal/set"
+	"github.com/oscal-compass/oscal-sdk-go/models/components"
+)
+
+var testCatalog = oscalTypes.Catalog{
+	Groups: &[]oscalTypes.Group{
+		{
+			ID: "grp-1",
+			Controls: &[]oscalTypes.Control{
+				{
+					ID: "ex-1",
+					Params: &[]oscalTypes.Parameter{
+						{
+							ID:     "catalog-param",
+							Values: &[]string{"catalog-value"},
+						},
+						{
+							ID:     "profile-param",
+							Values: &[]string{"catalog-value"},
+						},
+					},
+					Controls: &[]oscalTypes.Control{
+						{
+							ID: "ex-1.1",
+							Params: &[]oscalTypes.Parameter{
+								{
+									ID:     "enhancement-param",
+									Values: &[]string{"catalog-value"},
+								},
+								{
+									ID:     "multi-value-param",
+									Values: &[]string{"value-1", "value-2"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testProfile = oscalTypes.Profile{
+	Modify: &oscalTypes.Modify{
+		SetParameters: &[]oscalTypes.ParameterSetting{
+			{
+				ParamId: "profile-param",
+				Values:  &[]string{"profile-value"},
+			},
+			{
+				ParamId: "implementation-param",
+				Values:  &[]string{"profile-value"},
+			},
+		},
+	},
+}
+
+func TestNewProfileSettings(t *testing.T) {
+	tests := []struct {
+		name         string
+		catalog      *oscalTypes.Catalog
+		wantSettings Settings
+	}{
+		{
+			name:    "Valid/WithResolvedCatalog",
+			catalog: &testCatalog,
+			wantSettings: Settings{
+				mappedRules: set.Set[string]{},
+				selectedParameters: map[string]string{
+					"catalog-param":        "catalog-value",
+					"enhancement-param":    "catalog-value",
+					"profile-param":        "profile-value",
+					"implementation-param": "profile-value",
+				},
+			},
+		},
+		{
+			name: "Valid/ProfileOnly",
+			wantSettings: Settings{
+				mappedRules: set.Set[string]{},
+				selectedParameters: map[string]string{
+					"profile-param":        "profile-value",
+					"implementation-param": "profile-value",
+				},
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			gotSettings := NewProfileSettings(testProfile, c.catalog)
+			require.Equal(t, c.wantSettings, gotSettings)
+		})
+	}
+}
+
+func TestNewImplementationSettingsFromProfile(t *testing.T) {
+	implementation := oscalTypes.ControlImplementationSet{
+		SetParameters: &[]oscalTypes.SetParameter{
+			{
+				ParamId: "implementation-param",
+				Values:  []string{"implementation-value"},
+			},
+		},
+		ImplementedRequirements: []oscalTypes.ImplementedRequirementControlImplementation{
+			{
+				ControlId: "ex-1",
+				Props: &[]oscalTypes.Property{
+					{
+						Name:  extensions.RuleIdProp,
+						Value: "my-test-rule",
+						Ns:    extensions.TrestleNameSpace,
+					},
+				},
+			},
+			{
+				ControlId: "ex-2",
+				Props: &[]oscalTypes.Property{
+					{
+						Name:  extensions.RuleIdProp,
+						Value: "my-test-rule",
+						Ns:    extensions.TrestleNameSpace,
+					},
+				},
+				SetParameters: &[]oscalTypes.SetParameter{
+					{
+						ParamId: "profile-param",
+						Values:  []string{"requirement-value"},
+					},
+				},
+			},
+		},
+	}
+	adapter := components.NewControlImplementationSetAdapter(implementation)
+	gotSettings := NewImplementationSettingsFromProfile(adapter, testProfile, &testCatalog)
+
+	// Profile values take precedence over the implementation values, and
+	// catalog values are only used for parameters not set by the implementation.
+	expectedParameters := map[string]string{
+		"catalog-param":        "catalog-value",
+		"enhancement-param":    "catalog-value",
+		"profile-param":        "profile-value",
+		"implementation-param": "profile-value",
+	}
+	require.Equal(t, expectedParameters, gotSettings.AllSettings().selectedParameters)
+	require.True(t, gotSettings.AllSettings().ContainsRule("my-test-rule"))
+
+	// Profile values should be used for rules in a control
+	controlSettings, err := gotSettings.ApplicableSettings("my-test-rule", "ex-1")
+	require.NoError(t, err)
+	require.Equal(t, expectedParameters, controlSettings.selectedParameters)
+
+	// Requirement values take precedence over the profile values
+	controlSettings, err = gotSettings.ApplicableSettings("my-test-rule", "ex-2")
+	require.NoError(t, err)
+	require.Equal(t, "requirement-value", controlSettings.selectedParameters["profile-param"])
+	require.Equal(t, "profile-value", controlSettings.selectedParameters["implementation-param"])
+}
+
+func TestImplementationSettings_TailorCatalogDefaults(t *testing.T) {
+	implementation := oscalTypes.ControlImplementationSet{
+		SetParameters: &[]oscalTypes.SetParameter{
+			{
+				ParamId: "catalog-param",
+				Values:  []string{"implementation-value"},
+			},
+		},
+		ImplementedRequirements: []oscalTypes.ImplementedRequirementControlImplementation{
+			{
+				ControlId: "ex-1",
+				Props: &[]oscalTypes.Property{
+					{
+						Name:  extensions.RuleIdProp,
+						Value: "my-test-rule",
+						Ns:    extensions.TrestleNameSpace,
+					},
+				},
+			},
+		},
+	}
+	adapter := components.NewControlImplementationSetAdapter(implementation)
+	gotSettings := NewImplementationSettingsFromProfile(adapter, oscalTypes.Profile{}, &testCatalog)
+	require.Equal(t, "implementation-value", gotSettings.AllSettings().selectedParameters["catalog-param"])
+	require.Equal(t, "catalog-value", gotSettings.AllSettings().selectedParameters["enhancement-param"])
+}
@@ -53,6 +53,15 @@ func (i Settings) ContainsRule(ruleId string) bool {
 	return i.mappedRules.Has(ruleId)
 }
 
+// SelectedParameters returns a copy of the parameter ids and selected values defined in the Settings.
+func (i Settings) SelectedParameters() map[string]string {
+	parameters := make(map[string]string, len(i.selectedParameters))
+	for id, value := range i.selectedParameters {
+		parameters[id] = value
+	}
+	return parameters
+}
+
 // ApplyToComponent returns a list of RuleSets for a given component with options applied from the given Settings.
 //
 // Only the rules that overlap between the component and the mapped rules in the implementation are returned.
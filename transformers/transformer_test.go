@@ -12,11 +12,14 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 	"github.com/stretchr/testify/require"
 
+	"github.com/oscal-compass/oscal-sdk-go/extensions"
 	"github.com/oscal-compass/oscal-sdk-go/models"
+	"github.com/oscal-compass/oscal-sdk-go/settings"
 	"github.com/oscal-compass/oscal-sdk-go/validation"
 )
 
@@ -72,6 +75,345 @@ func TestComponentDefinitionsToAssessmentPlan(t *testing.T) {
 	require.NoError(t, validator.Validate(oscalModels))
 }
 
+func TestComponentDefinitionsToAssessmentPlan_WithProfileSettings(t *testing.T) {
+	testDataPath := filepath.Join("../testdata", "component-definition-test.json")
+
+	file, err := os.Open(testDataPath)
+	require.NoError(t, err)
+	definition, err := models.NewComponentDefinition(file, validation.NoopValidator{})
+	require.NoError(t, err)
+	require.NotNil(t, definition)
+
+	// Remove the parameter from the control implementation to use the profile value
+	components := *definition.Components
+	implementations := *components[0].ControlImplementations
+	implementations[0].SetParameters = nil
+
+	profile := oscalTypes.Profile{
+		Modify: &oscalTypes.Modify{
+			SetParameters: &[]oscalTypes.ParameterSetting{
+				{
+					ParamId: "file_name",
+					Values:  &[]string{"profile_value"},
+				},
+			},
+		},
+	}
+	profileSettings := settings.NewProfileSettings(profile, nil)
+
+	plan, err := ComponentDefinitionsToAssessmentPlan(context.TODO(), []oscalTypes.ComponentDefinition{*definition}, "cis", WithProfileSettings(profileSettings))
+	require.NoError(t, err)
+
+	var found bool
+	for _, act := range *plan.LocalDefinitions.Activities {
+		if act.Title != "etcd_key_file" {
+			continue
+		}
+		found = true
+		paramProps := extensions.FindAllProps(*act.Props, extensions.WithClass(extensions.TestParameterClass))
+		require.Len(t, paramProps, 1)
+		require.Equal(t, "profile_value", paramProps[0].Value)
+	}
+	require.True(t, found)
+}
+
+func TestTransforms_Reproducible(t *testing.T) {
+	lastModified := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	sspFile, err := os.Open(filepath.Join("../testdata", "test-ssp.json"))
+	require.NoError(t, err)
+	defer sspFile.Close()
+	ssp, err := models.NewSystemSecurityPlan(sspFile, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	definitionFile, err := os.Open(filepath.Join("../testdata", "component-definition-test.json"))
+	require.NoError(t, err)
+	defer definitionFile.Close()
+	definition, err := models.NewComponentDefinition(definitionFile, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	planFile, err := os.Open(filepath.Join("../testdata", "test-ap.json"))
+	require.NoError(t, err)
+	defer planFile.Close()
+	plan, err := models.NewAssessmentPlan(planFile, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	failingObservation := oscalTypes.Observation{
+		UUID:        "11111111-1111-4111-8111-111111111111",
+		Description: models.SampleRequiredString,
+		Methods:     []string{"TEST"},
+		Collected:   lastModified,
+		Props: &[]oscalTypes.Property{
+			{
+				Name:  extensions.AssessmentCheckIdProp,
+				Value: "check-1",
+				Ns:    extensions.TrestleNameSpace,
+			},
+			extensions.NewResultProp(extensions.ResultFail),
+		},
+	}
+	assessmentResults := func(opts ...TransformOption) *oscalTypes.AssessmentResults {
+		opts = append(opts, WithObservations([]oscalTypes.Observation{failingObservation}))
+		results, err := AssessmentPlanToAssessmentResults(*plan, "importPath", opts...)
+		require.NoError(t, err)
+		return results
+	}
+
+	tests := []struct {
+		name     string
+		generate func(opts ...TransformOption) (any, error)
+		assert   func(t *testing.T, data []byte)
+	}{
+		{
+			name: "SSPToAssessmentPlan",
+			generate: func(opts ...TransformOption) (any, error) {
+				return SSPToAssessmentPlan(context.TODO(), *ssp, "importPath", opts...)
+			},
+			assert: func(t *testing.T, data []byte) {
+				var plan oscalTypes.AssessmentPlan
+				require.NoError(t, json.Unmarshal(data, &plan))
+				require.True(t, lastModified.Equal(plan.Metadata.LastModified))
+				require.Equal(t, []oscalTypes.AssessedControlsSelectControlById{
+					{ControlId: "ex-1"},
+					{ControlId: "ex-2"},
+				}, *plan.ReviewedControls.ControlSelections[0].IncludeControls)
+				require.NoError(t, validation.NewSchemaValidator().Validate(oscalTypes.OscalModels{AssessmentPlan: &plan}))
+			},
+		},
+		{
+			name: "ComponentDefinitionsToAssessmentPlan",
+			generate: func(opts ...TransformOption) (any, error) {
+				return ComponentDefinitionsToAssessmentPlan(context.TODO(), []oscalTypes.ComponentDefinition{*definition}, "cis", opts...)
+			},
+			assert: func(t *testing.T, data []byte) {
+				var plan oscalTypes.AssessmentPlan
+				require.NoError(t, json.Unmarshal(data, &plan))
+				resources := *plan.BackMatter.Resources
+				links := *plan.ReviewedControls.Links
+				require.Equal(t, fmt.Sprintf("#%s", resources[0].UUID), links[0].Href)
+			},
+		},
+		{
+			name: "ComponentDefinitionsToSSP",
+			generate: func(opts ...TransformOption) (any, error) {
+				return ComponentDefinitionsToSSP([]oscalTypes.ComponentDefinition{*definition}, "cis", opts...)
+			},
+		},
+		{
+			name: "AssessmentPlanToAssessmentResults",
+			generate: func(opts ...TransformOption) (any, error) {
+				return assessmentResults(opts...), nil
+			},
+			assert: func(t *testing.T, data []byte) {
+				var results oscalTypes.AssessmentResults
+				require.NoError(t, json.Unmarshal(data, &results))
+				require.True(t, lastModified.Equal(results.Metadata.LastModified))
+				require.Len(t, *results.Results[0].Findings, 2)
+			},
+		},
+		{
+			name: "AssessmentResultsToPOAM",
+			generate: func(opts ...TransformOption) (any, error) {
+				return AssessmentResultsToPOAM(*assessmentResults(opts...), nil, opts...)
+			},
+			assert: func(t *testing.T, data []byte) {
+				var poam oscalTypes.PlanOfActionAndMilestones
+				require.NoError(t, json.Unmarshal(data, &poam))
+				require.True(t, lastModified.Equal(poam.Metadata.LastModified))
+				require.Len(t, poam.PoamItems, 1)
+			},
+		},
+		{
+			name: "MergeAssessmentResults",
+			generate: func(opts ...TransformOption) (any, error) {
+				return MergeAssessmentResults([]oscalTypes.AssessmentResults{*assessmentResults(opts...), *assessmentResults(opts...)}, opts...)
+			},
+		},
+	}
+
+	for _, c := range tests {
+		t.Run(c.name, func(t *testing.T) {
+			generate := func(namespace string) []byte {
+				model, err := c.generate(WithUUIDFunc(models.ContentUUID(namespace)), WithClock(models.FixedClock(lastModified)))
+				require.NoError(t, err)
+				data, err := json.Marshal(model)
+				require.NoError(t, err)
+				return data
+			}
+
+			first := generate("test")
+			// Generate several times so output depending on map iteration order is detected.
+			for i := 0; i < 5; i++ {
+				require.Equal(t, string(first), string(generate("test")))
+			}
+			require.NotEqual(t, string(first), string(generate("other")))
+			if c.assert != nil {
+				c.assert(t, first)
+			}
+		})
+	}
+}
+
+func TestComponentDefinitionsToAssessmentPlan_WithOrganization(t *testing.T) {
+	testDataPath := filepath.Join("../testdata", "component-definition-test.json")
+
+	file, err := os.Open(testDataPath)
+	require.NoError(t, err)
+	definition, err := models.NewComponentDefinition(file, validation.NoopValidator{})
+	require.NoError(t, err)
+	require.NotNil(t, definition)
+
+	org := models.Organization{Name: "Example Corp", Email: "compliance@example.com"}
+	plan, err := ComponentDefinitionsToAssessmentPlan(context.TODO(), []oscalTypes.ComponentDefinition{*definition}, "cis", WithOrganization(org))
+	require.NoError(t, err)
+
+	party, found := models.FindParty(plan.Metadata, org.Party().UUID)
+	require.True(t, found)
+	require.Equal(t, "Example Corp", party.Name)
+
+	// The organization is kept in Assessment Results generated from the plan
+	results, err := AssessmentPlanToAssessmentResults(*plan, "importPath")
+	require.NoError(t, err)
+	require.Equal(t, plan.Metadata.Parties, results.Metadata.Parties)
+	require.Equal(t, plan.Metadata.Roles, results.Metadata.Roles)
+	require.Equal(t, plan.Metadata.ResponsibleParties, results.Metadata.ResponsibleParties)
+
+	validator := validation.NewSchemaValidator()
+	require.NoError(t, validator.Validate(oscalTypes.OscalModels{AssessmentPlan: plan}))
+	require.NoError(t, validator.Validate(oscalTypes.OscalModels{AssessmentResults: results}))
+}
+
+func TestTransforms_WithOrganization(t *testing.T) {
+	org := models.Organization{Name: "Example Corp", Email: "compliance@example.com"}
+
+	definitionFile, err := os.Open(filepath.Join("../testdata", "component-definition-test.json"))
+	require.NoError(t, err)
+	defer definitionFile.Close()
+	definition, err := models.NewComponentDefinition(definitionFile, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	planFile, err := os.Open(filepath.Join("../testdata", "test-ap.json"))
+	require.NoError(t, err)
+	defer planFile.Close()
+	plan, err := models.NewAssessmentPlan(planFile, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	ssp, err := ComponentDefinitionsToSSP([]oscalTypes.ComponentDefinition{*definition}, "cis", WithOrganization(org))
+	require.NoError(t, err)
+	results, err := AssessmentPlanToAssessmentResults(*plan, "importPath", WithOrganization(org))
+	require.NoError(t, err)
+	poam, err := AssessmentResultsToPOAM(*results, nil, WithOrganization(org))
+	require.NoError(t, err)
+	merged, err := MergeAssessmentResults([]oscalTypes.AssessmentResults{*results}, WithOrganization(org))
+	require.NoError(t, err)
+
+	for name, metadata := range map[string]oscalTypes.Metadata{
+		"SSP":     ssp.Metadata,
+		"Results": results.Metadata,
+		"POAM":    poam.Metadata,
+		"Merged":  merged.Metadata,
+	} {
+		party, found := models.FindParty(metadata, org.Party().UUID)
+		require.True(t, found, name)
+		require.Equal(t, "Example Corp", party.Name, name)
+		_, found = models.FindRole(metadata, models.CreatorRole)
+		require.True(t, found, name)
+	}
+
+	validator := validation.NewSchemaValidator()
+	require.NoError(t, validator.Validate(oscalTypes.OscalModels{SystemSecurityPlan: ssp}))
+	require.NoError(t, validator.Validate(oscalTypes.OscalModels{AssessmentResults: merged}))
+}
+
+func TestComponentDefinitionsToAssessmentPlans(t *testing.T) {
+	testDataPath := filepath.Join("../testdata", "component-definition-test-reqs.json")
+
+	file, err := os.Open(testDataPath)
+	require.NoError(t, err)
+	definition, err := models.NewComponentDefinition(file, validation.NoopValidator{})
+	require.NoError(t, err)
+	require.NotNil(t, definition)
+
+	plans, err := ComponentDefinitionsToAssessmentPlans(context.TODO(), []oscalTypes.ComponentDefinition{*definition}, nil)
+	require.NoError(t, err)
+	require.Len(t, plans, 2)
+
+	expectedActivities := map[string][]string{
+		"cis":     {"etcd_cert_file", "etcd_key_file"},
+		"example": {"etcd_key_file"},
+	}
+	for framework, plan := range plans {
+		var activities []string
+		for _, act := range *plan.LocalDefinitions.Activities {
+			activities = append(activities, act.Title)
+		}
+		require.ElementsMatch(t, expectedActivities[framework], activities)
+
+		resources := *plan.BackMatter.Resources
+		require.Len(t, resources, 1)
+		require.Equal(t, framework, resources[0].Title)
+	}
+
+	plans, err = ComponentDefinitionsToAssessmentPlans(context.TODO(), []oscalTypes.ComponentDefinition{*definition}, []string{"cis"})
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
+	require.Contains(t, plans, "cis")
+
+	_, err = ComponentDefinitionsToAssessmentPlans(context.TODO(), []oscalTypes.ComponentDefinition{*definition}, []string{"doesnotexist"})
+	require.EqualError(t, err, "cannot transform definitions for framework doesnotexist: framework doesnotexist is not in control implementations")
+}
+
+func TestComponentDefinitionsToSSP(t *testing.T) {
+	testDataPath := filepath.Join("../testdata", "component-definition-test.json")
+
+	file, err := os.Open(testDataPath)
+	require.NoError(t, err)
+	definition, err := models.NewComponentDefinition(file, validation.NoopValidator{})
+	require.NoError(t, err)
+	require.NotNil(t, definition)
+
+	ssp, err := ComponentDefinitionsToSSP([]oscalTypes.ComponentDefinition{*definition}, "cis")
+	require.NoError(t, err)
+
+	require.Equal(t, "profiles/cis/profile.json", ssp.ImportProfile.Href)
+	require.Equal(t, "CIS Profile", ssp.ControlImplementation.Description)
+	// This System, TestKubernetes, Validator, Validator2
+	require.Len(t, ssp.SystemImplementation.Components, 4)
+	require.Len(t, ssp.ControlImplementation.ImplementedRequirements, 1)
+
+	implementedReq := ssp.ControlImplementation.ImplementedRequirements[0]
+	require.Equal(t, "CIS-2.1", implementedReq.ControlId)
+	require.Len(t, *implementedReq.ByComponents, 1)
+	byComp := (*implementedReq.ByComponents)[0]
+	require.Equal(t, "c8106bc8-5174-4e86-91a4-52f2fe0ed027", byComp.ComponentUuid)
+	require.Len(t, *byComp.Props, 2)
+	require.Equal(t, []oscalTypes.SetParameter{{ParamId: "file_name", Values: []string{"file_name_override"}}}, *byComp.SetParameters)
+
+	// Validate against the schema
+	validator := validation.NewSchemaValidator()
+	oscalModels := oscalTypes.OscalModels{
+		SystemSecurityPlan: ssp,
+	}
+	require.NoError(t, validator.Validate(oscalModels))
+
+	// The generated SSP should produce the same activities as the component definition
+	plan, err := SSPToAssessmentPlan(context.TODO(), *ssp, "importPath")
+	require.NoError(t, err)
+	var activities []string
+	for _, act := range *plan.LocalDefinitions.Activities {
+		activities = append(activities, act.Title)
+		if act.Title == "etcd_key_file" {
+			paramProps := extensions.FindAllProps(*act.Props, extensions.WithClass(extensions.TestParameterClass))
+			require.Len(t, paramProps, 1)
+			require.Equal(t, "file_name_override", paramProps[0].Value)
+		}
+	}
+	require.ElementsMatch(t, []string{"etcd_cert_file", "etcd_key_file"}, activities)
+
+	_, err = ComponentDefinitionsToSSP([]oscalTypes.ComponentDefinition{*definition}, "doesnotexist")
+	require.EqualError(t, err, "cannot transform definitions for framework doesnotexist: framework doesnotexist is not in control implementations")
+}
+
 func TestSSPToAssessmentPlan(t *testing.T) {
 	testDataPath := filepath.Join("../testdata", "test-ssp.json")
 
@@ -118,6 +460,8 @@ func TestAssessmentPlanToAssessmentResults(t *testing.T) {
 
 	results, err := AssessmentPlanToAssessmentResults(*plan, "importPath")
 	require.NoError(t, err)
+	// Checks without a result are not assessed
+	require.Nil(t, results.Results[0].Findings)
 
 	// Validate against the schema
 	validator := validation.NewSchemaValidator()
@@ -125,4 +469,104 @@ func TestAssessmentPlanToAssessmentResults(t *testing.T) {
 		AssessmentResults: results,
 	}
 	require.NoError(t, validator.Validate(oscalModels))
+
+	passingObservation := oscalTypes.Observation{
+		UUID:        "11111111-1111-4111-8111-111111111111",
+		Description: models.SampleRequiredString,
+		Methods:     []string{"TEST"},
+		Collected:   time.Now(),
+		Props: &[]oscalTypes.Property{
+			{
+				Name:  extensions.AssessmentCheckIdProp,
+				Value: "check-1",
+				Ns:    extensions.TrestleNameSpace,
+			},
+			extensions.NewResultProp(extensions.ResultPass),
+		},
+	}
+	results, err = AssessmentPlanToAssessmentResults(*plan, "importPath",
+		WithObservations([]oscalTypes.Observation{passingObservation}),
+		WithSatisfiedFunc(func(controlId string, _ []oscalTypes.Observation) bool {
+			return controlId == "ex-1"
+		}),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, results.Results[0].Findings)
+	states := make(map[string]string)
+	for _, finding := range *results.Results[0].Findings {
+		states[finding.Target.TargetId] = finding.Target.Status.State
+	}
+	require.Equal(t, map[string]string{"ex-1_smt": "satisfied", "ex-2_smt": "not-satisfied"}, states)
+}
+
+func TestAssessmentResultsToPOAM(t *testing.T) {
+	testDataPath := filepath.Join("../testdata", "test-ap.json")
+
+	file, err := os.Open(testDataPath)
+	require.NoError(t, err)
+	plan, err := models.NewAssessmentPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+	require.NotNil(t, plan)
+
+	results, err := AssessmentPlanToAssessmentResults(*plan, "importPath")
+	require.NoError(t, err)
+
+	failingObservation := oscalTypes.Observation{
+		UUID:        "11111111-1111-4111-8111-111111111111",
+		Description: models.SampleRequiredString,
+		Methods:     []string{"TEST"},
+		Collected:   time.Now(),
+		Props: &[]oscalTypes.Property{
+			{
+				Name:  extensions.AssessmentCheckIdProp,
+				Value: "etcd_cert_file",
+				Ns:    extensions.TrestleNameSpace,
+			},
+			extensions.NewResultProp(extensions.ResultFail),
+		},
+	}
+	results.Results[0].Observations = &[]oscalTypes.Observation{failingObservation}
+
+	poam, err := AssessmentResultsToPOAM(*results, nil)
+	require.NoError(t, err)
+	require.Len(t, poam.PoamItems, 1)
+
+	validator := validation.NewSchemaValidator()
+	oscalModels := oscalTypes.OscalModels{
+		PlanOfActionAndMilestones: poam,
+	}
+	require.NoError(t, validator.Validate(oscalModels))
+
+	// Update the existing POA&M with no changes in results
+	updated, err := AssessmentResultsToPOAM(*results, poam)
+	require.NoError(t, err)
+	require.Equal(t, poam.UUID, updated.UUID)
+	require.Len(t, updated.PoamItems, 1)
+	require.Len(t, *updated.Observations, 1)
+}
+
+func TestMergeAssessmentResults(t *testing.T) {
+	testDataPath := filepath.Join("../testdata", "test-ap.json")
+
+	file, err := os.Open(testDataPath)
+	require.NoError(t, err)
+	plan, err := models.NewAssessmentPlan(file, validation.NoopValidator{})
+	require.NoError(t, err)
+
+	firstRun, err := AssessmentPlanToAssessmentResults(*plan, "importPath")
+	require.NoError(t, err)
+	secondRun, err := AssessmentPlanToAssessmentResults(*plan, "importPath")
+	require.NoError(t, err)
+
+	merged, err := MergeAssessmentResults([]oscalTypes.AssessmentResults{*firstRun, *secondRun})
+	require.NoError(t, err)
+	require.Len(t, merged.Results, 2)
+	require.Nil(t, merged.Results[0].Observations)
+	require.Len(t, *merged.Results[1].Observations, 1)
+
+	validator := validation.NewSchemaValidator()
+	oscalModels := oscalTypes.OscalModels{
+		AssessmentResults: merged,
+	}
+	require.NoError(t, validator.Validate(oscalModels))
 }
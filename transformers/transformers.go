@@ -9,18 +9,171 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/defenseunicorns/go-oscal/src/pkg/uuid"
 	oscalTypes "github.com/defenseunicorns/go-oscal/src/types/oscal-1-1-3"
 
 	"github.com/oscal-compass/oscal-sdk-go/internal/plans"
+	"github.com/oscal-compass/oscal-sdk-go/internal/poams"
 	"github.com/oscal-compass/oscal-sdk-go/internal/results"
+	"github.com/oscal-compass/oscal-sdk-go/internal/ssps"
+	"github.com/oscal-compass/oscal-sdk-go/models"
 	"github.com/oscal-compass/oscal-sdk-go/models/components"
+	"github.com/oscal-compass/oscal-sdk-go/rules"
 	"github.com/oscal-compass/oscal-sdk-go/settings"
 )
 
+type transformOpts struct {
+	profileSettings *settings.Settings
+	generateOptions []plans.GenerateOption
+	uuid            models.UUIDFunc
+	clock           models.Clock
+	org             *models.Organization
+	observations    []oscalTypes.Observation
+	satisfied       SatisfiedFunc
+}
+
+// TransformOption defines an option to tune the behavior of transformations.
+type TransformOption func(opts *transformOpts)
+
+// SatisfiedFunc determines whether a control is satisfied based on the
+// observations with a result for all rules mapped to the control.
+type SatisfiedFunc = results.SatisfiedFunc
+
+// WithProfileSettings is a TransformOption that tailors rule parameter values with
+// Settings derived from an OSCAL Profile with settings.NewProfileSettings.
+//
+// Profile parameter values take precedence over the values set in the control implementation, and the values set
+// for an implemented requirement or statement take precedence over the profile values. See settings.ImplementationSettings.Tailor.
+func WithProfileSettings(profileSettings settings.Settings) TransformOption {
+	return func(opts *transformOpts) {
+		opts.profileSettings = &profileSettings
+	}
+}
+
+// WithUUIDFunc is a TransformOption that sets the source of UUIDs for generated Assessment Plans, Assessment Results,
+// System Security Plans and Plans of Action and Milestones.
+//
+// Use models.ContentUUID to generate byte-identical models for unchanged inputs. When several
+// plans are generated, the content passed to the UUIDFunc is prefixed with the framework short name.
+func WithUUIDFunc(uuidFunc models.UUIDFunc) TransformOption {
+	return func(opts *transformOpts) {
+		opts.uuid = uuidFunc
+	}
+}
+
+// WithClock is a TransformOption that sets the source of timestamps for generated Assessment Plans, Assessment Results,
+// System Security Plans and Plans of Action and Milestones.
+func WithClock(clock models.Clock) TransformOption {
+	return func(opts *transformOpts) {
+		opts.clock = clock
+	}
+}
+
+// WithOrganization is a TransformOption that adds the organization generating Assessment Plans, Assessment Results,
+// System Security Plans and Plans of Action and Milestones to the metadata with the creator role. Assessment Results
+// generated from the plans also keep the organizations in the plan metadata.
+func WithOrganization(org models.Organization) TransformOption {
+	return func(opts *transformOpts) {
+		opts.org = &org
+	}
+}
+
+// WithObservations is a TransformOption that adds pre-processed OSCAL Observations to
+// Assessment Results generated with AssessmentPlanToAssessmentResults.
+func WithObservations(observations []oscalTypes.Observation) TransformOption {
+	return func(opts *transformOpts) {
+		opts.observations = observations
+	}
+}
+
+// WithSatisfiedFunc is a TransformOption that sets the logic used to determine whether a control
+// is satisfied in the Findings of Assessment Results generated with AssessmentPlanToAssessmentResults.
+//
+// If not set, a control is satisfied only when all the related observations with a result pass.
+func WithSatisfiedFunc(satisfied SatisfiedFunc) TransformOption {
+	return func(opts *transformOpts) {
+		opts.satisfied = satisfied
+	}
+}
+
+// scopedUUID returns the UUIDFunc for a generated model with the content prefixed
+// by the given scope.
+func (t transformOpts) scopedUUID(scope string) models.UUIDFunc {
+	if t.uuid == nil {
+		return models.RandomUUID
+	}
+	if scope == "" {
+		return t.uuid
+	}
+	return func(content string) string {
+		return t.uuid(fmt.Sprintf("%s/%s", scope, content))
+	}
+}
+
+// planOptions returns the options for generating an Assessment Plan with UUIDs
+// scoped by the given scope.
+func (t transformOpts) planOptions(scope string, opts ...plans.GenerateOption) []plans.GenerateOption {
+	generateOptions := make([]plans.GenerateOption, 0, len(t.generateOptions)+len(opts)+3)
+	generateOptions = append(generateOptions, t.generateOptions...)
+	generateOptions = append(generateOptions, opts...)
+	generateOptions = append(generateOptions, plans.WithUUIDFunc(t.scopedUUID(scope)))
+	if t.clock != nil {
+		generateOptions = append(generateOptions, plans.WithClock(t.clock))
+	}
+	if t.org != nil {
+		generateOptions = append(generateOptions, plans.WithOrganization(*t.org))
+	}
+	return generateOptions
+}
+
 // ComponentDefinitionsToAssessmentPlan transforms the data from one or more OSCAL Component Definitions to a single OSCAL Assessment Plan.
-func ComponentDefinitionsToAssessmentPlan(ctx context.Context, definitions []oscalTypes.ComponentDefinition, framework string) (*oscalTypes.AssessmentPlan, error) {
-	// Collect and aggregate all component information for each component definition
+func ComponentDefinitionsToAssessmentPlan(ctx context.Context, definitions []oscalTypes.ComponentDefinition, framework string, opts ...TransformOption) (*oscalTypes.AssessmentPlan, error) {
+	options := transformOpts{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	allComponents, allImplementations := collectDefinitions(definitions)
+	return assessmentPlanForFramework(ctx, allComponents, allImplementations, framework, options)
+}
+
+// ComponentDefinitionsToAssessmentPlans transforms the data from one or more OSCAL Component Definitions to an OSCAL Assessment Plan
+// for each given framework. The returned plans are indexed by framework short name.
+//
+// If no frameworks are given, an Assessment Plan is generated for every framework found in the Component Definitions. The rules
+// in the components are indexed once and shared between all generated plans.
+func ComponentDefinitionsToAssessmentPlans(ctx context.Context, definitions []oscalTypes.ComponentDefinition, frameworks []string, opts ...TransformOption) (map[string]*oscalTypes.AssessmentPlan, error) {
+	options := transformOpts{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if len(frameworks) == 0 {
+		for _, framework := range settings.Frameworks(definitions) {
+			frameworks = append(frameworks, framework.ShortName)
+		}
+	}
+
+	allComponents, allImplementations := collectDefinitions(definitions)
+	memoryStore := rules.NewMemoryStore()
+	if err := memoryStore.IndexAll(allComponents); err != nil {
+		return nil, fmt.Errorf("cannot transform definitions: %w", err)
+	}
+	options.generateOptions = append(options.generateOptions, plans.WithRulesStore(memoryStore))
+
+	assessmentPlans := make(map[string]*oscalTypes.AssessmentPlan, len(frameworks))
+	for _, framework := range frameworks {
+		assessmentPlan, err := assessmentPlanForFramework(ctx, allComponents, allImplementations, framework, options)
+		if err != nil {
+			return nil, err
+		}
+		assessmentPlans[framework] = assessmentPlan
+	}
+	return assessmentPlans, nil
+}
+
+// collectDefinitions collects and aggregates all component and control implementation information
+// for each component definition.
+func collectDefinitions(definitions []oscalTypes.ComponentDefinition) ([]components.Component, []oscalTypes.ControlImplementationSet) {
 	var allComponents []components.Component
 	var allImplementations []oscalTypes.ControlImplementationSet
 	for _, compDef := range definitions {
@@ -37,18 +190,29 @@ func ComponentDefinitionsToAssessmentPlan(ctx context.Context, definitions []osc
 			}
 		}
 	}
+	return allComponents, allImplementations
+}
+
+// assessmentPlanForFramework generates an Assessment Plan for the given components and
+// the control implementations of a single framework.
+func assessmentPlanForFramework(ctx context.Context, allComponents []components.Component, allImplementations []oscalTypes.ControlImplementationSet, framework string, options transformOpts) (*oscalTypes.AssessmentPlan, error) {
 	implementationSettings, frameworkSrc, err := settings.ByFramework(framework, allImplementations)
 	if err != nil || implementationSettings == nil {
 		return nil, fmt.Errorf("cannot transform definitions for framework %s: %w", framework, err)
 	}
-	assessmentPlan, err := plans.GenerateAssessmentPlan(ctx, allComponents, *implementationSettings)
+	if options.profileSettings != nil {
+		implementationSettings.Tailor(*options.profileSettings)
+	}
+	assessmentPlan, err := plans.GenerateAssessmentPlan(ctx, allComponents, *implementationSettings, options.planOptions(framework)...)
 	if err != nil {
 		return nil, err
 	}
 
 	// Add control source resource to maintain traceability to original control set.
-	controlSource := oscalTypes.Resource{
-		UUID:        uuid.NewUUID(),
+	if assessmentPlan.BackMatter == nil {
+		assessmentPlan.BackMatter = &oscalTypes.BackMatter{}
+	}
+	controlSource := models.AddResource(assessmentPlan.BackMatter, oscalTypes.Resource{
 		Description: frameworkSrc.Description,
 		Title:       frameworkSrc.Title,
 		Rlinks: &[]oscalTypes.ResourceLink{
@@ -57,11 +221,7 @@ func ComponentDefinitionsToAssessmentPlan(ctx context.Context, definitions []osc
 				Href:      frameworkSrc.Href,
 			},
 		},
-	}
-	backmatter := oscalTypes.BackMatter{
-		Resources: &[]oscalTypes.Resource{controlSource},
-	}
-	assessmentPlan.BackMatter = &backmatter
+	}, models.WithResourceUUIDFunc(options.scopedUUID(framework)))
 
 	// Add a link to the ReviewedControls to source
 	sourceRef := oscalTypes.Link{
@@ -79,8 +239,75 @@ func ComponentDefinitionsToAssessmentPlan(ctx context.Context, definitions []osc
 	return assessmentPlan, nil
 }
 
+// ComponentDefinitionsToSSP transforms the data from one or more OSCAL Component Definitions to a single OSCAL System Security Plan
+// for a given framework.
+//
+// The imported profile is set to the control source of the framework. Validation components are added to the
+// System Security Plan to support Assessment Plan generation with SSPToAssessmentPlan.
+func ComponentDefinitionsToSSP(definitions []oscalTypes.ComponentDefinition, framework string, opts ...TransformOption) (*oscalTypes.SystemSecurityPlan, error) {
+	options := transformOpts{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var (
+		allComponents []ssps.ComponentImplementation
+		frameworkSrc  settings.FrameworkSource
+	)
+	for _, compDef := range definitions {
+		if compDef.Components == nil {
+			continue
+		}
+		for _, comp := range *compDef.Components {
+			componentImplementation := ssps.ComponentImplementation{
+				Component: components.NewDefinedComponentAdapter(comp),
+			}
+			if comp.ControlImplementations != nil {
+				for _, controlImplementation := range *comp.ControlImplementations {
+					frameworkShortName, found := settings.GetFrameworkShortName(controlImplementation)
+					if !found || frameworkShortName != framework {
+						continue
+					}
+					if frameworkSrc.Href == "" {
+						frameworkSrc.Title = framework
+						frameworkSrc.Description = controlImplementation.Description
+						frameworkSrc.Href = controlImplementation.Source
+					}
+					implementationAdapter := components.NewControlImplementationSetAdapter(controlImplementation)
+					componentImplementation.Implementations = append(componentImplementation.Implementations, implementationAdapter)
+				}
+			}
+			if len(componentImplementation.Implementations) > 0 || comp.Type == string(components.Validation) {
+				allComponents = append(allComponents, componentImplementation)
+			}
+		}
+	}
+
+	if frameworkSrc.Href == "" {
+		return nil, fmt.Errorf("cannot transform definitions for framework %s: framework %s is not in control implementations", framework, framework)
+	}
+
+	generateOptions := []ssps.GenerateOption{
+		ssps.WithImport(frameworkSrc.Href),
+		ssps.WithDescription(frameworkSrc.Description),
+		ssps.WithUUIDFunc(options.scopedUUID("")),
+	}
+	if options.clock != nil {
+		generateOptions = append(generateOptions, ssps.WithClock(options.clock))
+	}
+	if options.org != nil {
+		generateOptions = append(generateOptions, ssps.WithOrganization(*options.org))
+	}
+	return ssps.GenerateSystemSecurityPlan(allComponents, generateOptions...)
+}
+
 // SSPToAssessmentPlan transforms the data from a System Security Plan at a given import location to a single OSCAL Assessment Plan.
-func SSPToAssessmentPlan(ctx context.Context, ssp oscalTypes.SystemSecurityPlan, sspImportPath string) (*oscalTypes.AssessmentPlan, error) {
+func SSPToAssessmentPlan(ctx context.Context, ssp oscalTypes.SystemSecurityPlan, sspImportPath string, opts ...TransformOption) (*oscalTypes.AssessmentPlan, error) {
+	options := transformOpts{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	var allComponents []components.Component
 	for _, sysComp := range ssp.SystemImplementation.Components {
 		componentAdapter := components.NewSystemComponentAdapter(sysComp)
@@ -97,17 +324,84 @@ func SSPToAssessmentPlan(ctx context.Context, ssp oscalTypes.SystemSecurityPlan,
 	if implementationSettings == nil {
 		return nil, fmt.Errorf("cannot transform ssp at path %s", sspImportPath)
 	}
+	if options.profileSettings != nil {
+		implementationSettings.Tailor(*options.profileSettings)
+	}
 
-	return plans.GenerateAssessmentPlan(ctx, allComponents, *implementationSettings, plans.WithImport(sspImportPath))
+	return plans.GenerateAssessmentPlan(ctx, allComponents, *implementationSettings, options.planOptions("", plans.WithImport(sspImportPath))...)
 }
 
 // AssessmentPlanToAssessmentResults transforms the data from an Assessment Plan at a given import location to OSCAL Assessment Results.
-func AssessmentPlanToAssessmentResults(plan oscalTypes.AssessmentPlan, apImportPath string, observations ...oscalTypes.Observation) (*oscalTypes.AssessmentResults, error) {
-	options := []results.GenerateOption{
+//
+// Use WithObservations to add the Observations collected for the plan activities.
+func AssessmentPlanToAssessmentResults(plan oscalTypes.AssessmentPlan, apImportPath string, opts ...TransformOption) (*oscalTypes.AssessmentResults, error) {
+	options := transformOpts{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	generateOptions := []results.GenerateOption{
 		results.WithImport(apImportPath),
+		results.WithUUIDFunc(options.scopedUUID("")),
+	}
+	if options.clock != nil {
+		generateOptions = append(generateOptions, results.WithClock(options.clock))
+	}
+	if options.org != nil {
+		generateOptions = append(generateOptions, results.WithOrganization(*options.org))
+	}
+	if options.observations != nil {
+		generateOptions = append(generateOptions, results.WithObservations(options.observations))
+	}
+	if options.satisfied != nil {
+		generateOptions = append(generateOptions, results.WithSatisfiedFunc(options.satisfied))
+	}
+	return results.GenerateAssessmentResults(plan, generateOptions...)
+}
+
+// AssessmentResultsToPOAM transforms the failing checks in OSCAL Assessment Results to an OSCAL Plan of Action and Milestones.
+//
+// If an existing Plan of Action and Milestones is given, it is updated in place. Items for checks that now pass have the
+// associated risks closed.
+func AssessmentResultsToPOAM(assessmentResults oscalTypes.AssessmentResults, existing *oscalTypes.PlanOfActionAndMilestones, opts ...TransformOption) (*oscalTypes.PlanOfActionAndMilestones, error) {
+	options := transformOpts{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	generateOptions := []poams.GenerateOption{
+		poams.WithUUIDFunc(options.scopedUUID("")),
+	}
+	if options.clock != nil {
+		generateOptions = append(generateOptions, poams.WithClock(options.clock))
+	}
+	if options.org != nil {
+		generateOptions = append(generateOptions, poams.WithOrganization(*options.org))
+	}
+	if existing != nil {
+		generateOptions = append(generateOptions, poams.WithExisting(existing))
+	}
+	return poams.GeneratePOAM(assessmentResults, generateOptions...)
+}
+
+// MergeAssessmentResults merges OSCAL Assessment Results from several runs against the same Assessment Plan into one.
+//
+// The result entries from each run are kept. Observations for the same check and subjects are deduplicated with the
+// origins of all runs preserved, and findings for the same target are reconciled.
+func MergeAssessmentResults(assessmentResults []oscalTypes.AssessmentResults, opts ...TransformOption) (*oscalTypes.AssessmentResults, error) {
+	options := transformOpts{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	generateOptions := []results.GenerateOption{
+		results.WithUUIDFunc(options.scopedUUID("")),
+	}
+	if options.clock != nil {
+		generateOptions = append(generateOptions, results.WithClock(options.clock))
 	}
-	if observations != nil {
-		options = append(options, results.WithObservations(observations))
+	if options.org != nil {
+		generateOptions = append(generateOptions, results.WithOrganization(*options.org))
 	}
-	return results.GenerateAssessmentResults(plan, options...)
+	return results.MergeAssessmentResults(assessmentResults, generateOptions...)
 }